@@ -0,0 +1,57 @@
+// Package auth implements a credential registry that lets a single machine
+// hold more than one JIRA or GitHub identity (e.g. two JIRA instances, or a
+// personal and an enterprise GitHub account) without re-running the config
+// wizard every time the user switches between them.
+package auth
+
+// Credential kinds recognized by the Store. New backends should add a
+// constant here rather than invent ad-hoc strings.
+const (
+	KindJiraBasic  = "jira-basic"
+	KindJiraOAuth1 = "jira-oauth1"
+	// KindJiraSession is a JIRA Server username/password pair authenticated
+	// via the /rest/auth/1/session cookie login (see jira.NewSessionClient).
+	KindJiraSession = "jira-session"
+	// KindJiraToken is a bare bearer token: a JIRA Server/Data Center
+	// personal access token, sent as "Authorization: Bearer <token>" with
+	// no login step.
+	KindJiraToken = "jira-token"
+	// KindJiraOAuth2 is a refresh-token-capable OAuth 2.0 identity (e.g. an
+	// Atlassian Cloud 3LO app). Its secret is an EncodeOAuth2Token blob;
+	// RefreshOAuth2 rotates it using the client_id/client_secret/
+	// token_endpoint stored in its Metadata.
+	KindJiraOAuth2  = "jira-oauth2"
+	KindGitHubToken = "github-token"
+	KindGitHubApp   = "github-app"
+)
+
+// Credential describes a stored identity. Secret material (tokens, API
+// keys, private key passphrases, ...) never lives on this struct; it is
+// looked up from the OS keyring using ID() as the account key.
+type Credential interface {
+	// ID is a stable, unique identifier for this credential (a UUID).
+	ID() string
+	// Kind identifies the credential backend, one of the Kind* constants.
+	Kind() string
+	// Target is the server URL (JIRA) or host (GitHub) this credential
+	// authenticates against.
+	Target() string
+	// Metadata returns backend-specific, non-secret fields (e.g. "email"
+	// for jira-basic, "consumer_key" for jira-oauth1).
+	Metadata() map[string]string
+}
+
+// StoredCredential is the concrete, YAML-serializable Credential
+// implementation persisted by Store.
+type StoredCredential struct {
+	UUID       string            `yaml:"id"`
+	KindName   string            `yaml:"kind"`
+	TargetURL  string            `yaml:"target"`
+	MetadataMp map[string]string `yaml:"metadata,omitempty"`
+	IsDefault  bool              `yaml:"default,omitempty"`
+}
+
+func (c StoredCredential) ID() string                  { return c.UUID }
+func (c StoredCredential) Kind() string                { return c.KindName }
+func (c StoredCredential) Target() string              { return c.TargetURL }
+func (c StoredCredential) Metadata() map[string]string { return c.MetadataMp }
@@ -0,0 +1,25 @@
+package auth
+
+import (
+	appconfig "git-autometa/internal/config"
+	"git-autometa/internal/secrets"
+)
+
+// MigrateLegacyJira moves the single JIRA basic-auth identity historically
+// configured via `jira.email` + the OS keyring into a default jira-basic
+// credential in store, the first time the credential store is used on a
+// machine that already has a working single-token setup. It is a no-op if
+// a jira-basic default already exists, or if there is nothing to migrate.
+func MigrateLegacyJira(store *Store, cfg appconfig.Config) (string, error) {
+	if cred, err := store.Default(KindJiraBasic, ""); err == nil {
+		return cred.ID(), nil
+	}
+	if cfg.Jira.Email == "" {
+		return "", nil
+	}
+	token, err := secrets.GetJiraToken(cfg)
+	if err != nil || token == "" {
+		return "", nil
+	}
+	return store.Add(KindJiraBasic, cfg.Jira.ServerURL, map[string]string{"email": cfg.Jira.Email}, token)
+}
@@ -0,0 +1,110 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// OAuth2Token is the access/refresh token pair persisted as the keyring
+// secret for a KindJiraOAuth2 credential.
+type OAuth2Token struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token"`
+	Expiry       time.Time `json:"expiry"`
+}
+
+// Expired reports whether the token is past its Expiry. A zero Expiry
+// (unknown lifetime) is treated as never expired.
+func (t OAuth2Token) Expired() bool {
+	return !t.Expiry.IsZero() && time.Now().After(t.Expiry)
+}
+
+// EncodeOAuth2Token serializes t for storage as a Store secret.
+func EncodeOAuth2Token(t OAuth2Token) (string, error) {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return "", fmt.Errorf("auth: unable to encode oauth2 token: %w", err)
+	}
+	return string(data), nil
+}
+
+// DecodeOAuth2Token parses a secret previously produced by EncodeOAuth2Token.
+func DecodeOAuth2Token(secret string) (OAuth2Token, error) {
+	var t OAuth2Token
+	if err := json.Unmarshal([]byte(secret), &t); err != nil {
+		return OAuth2Token{}, fmt.Errorf("auth: malformed oauth2 token: %w", err)
+	}
+	return t, nil
+}
+
+// RefreshOAuth2 exchanges cred's refresh token for a new access token via
+// the standard OAuth 2.0 refresh_token grant against tokenEndpoint (e.g.
+// Atlassian's https://auth.atlassian.com/oauth/token), persists the
+// rotated pair back into store, and returns it.
+func RefreshOAuth2(store *Store, cred Credential, clientID, clientSecret, tokenEndpoint string) (OAuth2Token, error) {
+	current, err := store.Secret(cred.ID())
+	if err != nil {
+		return OAuth2Token{}, err
+	}
+	tok, err := DecodeOAuth2Token(current)
+	if err != nil {
+		return OAuth2Token{}, err
+	}
+	if tok.RefreshToken == "" {
+		return OAuth2Token{}, errors.New("auth: oauth2 credential has no refresh token")
+	}
+	if tokenEndpoint == "" {
+		return OAuth2Token{}, errors.New("auth: oauth2 credential has no token endpoint")
+	}
+
+	form := url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {tok.RefreshToken},
+		"client_id":     {clientID},
+		"client_secret": {clientSecret},
+	}
+	resp, err := http.PostForm(tokenEndpoint, form)
+	if err != nil {
+		return OAuth2Token{}, fmt.Errorf("auth: oauth2 refresh request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4<<10))
+		return OAuth2Token{}, fmt.Errorf("auth: oauth2 refresh failed: %s: %s", resp.Status, string(body))
+	}
+
+	var payload struct {
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
+		ExpiresIn    int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return OAuth2Token{}, fmt.Errorf("auth: unable to parse oauth2 refresh response: %w", err)
+	}
+	if payload.RefreshToken == "" {
+		// Some providers only rotate the refresh token occasionally; keep
+		// the old one when the response doesn't include a new one.
+		payload.RefreshToken = tok.RefreshToken
+	}
+	next := OAuth2Token{
+		AccessToken:  payload.AccessToken,
+		RefreshToken: payload.RefreshToken,
+	}
+	if payload.ExpiresIn > 0 {
+		next.Expiry = time.Now().Add(time.Duration(payload.ExpiresIn) * time.Second)
+	}
+
+	encoded, err := EncodeOAuth2Token(next)
+	if err != nil {
+		return OAuth2Token{}, err
+	}
+	if err := store.UpdateSecret(cred.ID(), encoded); err != nil {
+		return OAuth2Token{}, err
+	}
+	return next, nil
+}
@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"os"
+	"strings"
+
+	"git-autometa/internal/secrets"
+)
+
+// ResolveToken looks up a secret using the module's standard layered
+// precedence: an explicit value (typically a config field, already
+// checked by the caller), then the given environment variable, then a
+// matching ~/.netrc entry for host. Returns "" if none of them yield a
+// value, leaving it to the caller to decide whether that's an error.
+//
+// Centralizing the order here (rather than duplicating it in every
+// package that needs a token) is what lets the GitHub, GitLab, and
+// Gitea/Forgejo clients, and JIRA's bearer-token mode, all fall back to
+// $GITHUB_TOKEN/$GITLAB_TOKEN/$GITEA_TOKEN/$JIRA_API_TOKEN and ~/.netrc
+// the same way, so the module works headless without `gh auth login` or
+// a credential store entry.
+func ResolveToken(explicit, envVar, host string) string {
+	if v := strings.TrimSpace(explicit); v != "" {
+		return v
+	}
+	if envVar != "" {
+		if v := strings.TrimSpace(os.Getenv(envVar)); v != "" {
+			return v
+		}
+	}
+	return netrcPassword(host)
+}
+
+// netrcPassword looks up the password for host in ~/.netrc (via
+// secrets.NetrcEntry, shared with secrets.NetrcProvider), falling back to
+// the account field (some tools store bearer tokens there instead). No
+// matching entry resolves to "".
+func netrcPassword(host string) string {
+	m := secrets.NetrcEntry(host)
+	if m == nil {
+		return ""
+	}
+	if pw := m.Get("password"); pw != "" {
+		return pw
+	}
+	return m.Get("account")
+}
@@ -0,0 +1,221 @@
+package auth
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/adrg/xdg"
+	keyring "github.com/zalando/go-keyring"
+	"gopkg.in/yaml.v3"
+)
+
+// keyringService namespaces credential secrets in the OS keyring, separate
+// from the single-identity secrets the jira/github packages historically
+// wrote directly (see internal/secrets).
+const keyringService = "git-autometa-auth"
+
+// Store persists credential metadata as YAML under the XDG config dir,
+// while keeping the actual secret (token, password, ...) in the OS keyring
+// keyed by the credential's UUID.
+type Store struct {
+	path string
+}
+
+// NewStore opens the default credential store, at
+// <xdg-config>/git-autometa/credentials.yaml.
+func NewStore() (*Store, error) {
+	path, err := xdg.ConfigFile(filepath.Join("git-autometa", "credentials.yaml"))
+	if err != nil {
+		return nil, fmt.Errorf("auth: unable to resolve credential store path: %w", err)
+	}
+	return &Store{path: path}, nil
+}
+
+func (s *Store) load() ([]StoredCredential, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var creds []StoredCredential
+	if err := yaml.Unmarshal(data, &creds); err != nil {
+		return nil, fmt.Errorf("auth: unable to parse credential store: %w", err)
+	}
+	return creds, nil
+}
+
+func (s *Store) save(creds []StoredCredential) error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return err
+	}
+	data, err := yaml.Marshal(creds)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, data, 0o600)
+}
+
+// List returns every stored credential, in no particular order.
+func (s *Store) List() ([]Credential, error) {
+	creds, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Credential, 0, len(creds))
+	for _, c := range creds {
+		out = append(out, c)
+	}
+	return out, nil
+}
+
+// Get returns the credential with the given ID.
+func (s *Store) Get(id string) (Credential, error) {
+	creds, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range creds {
+		if c.UUID == id {
+			return c, nil
+		}
+	}
+	return nil, fmt.Errorf("auth: no credential with id %q", id)
+}
+
+// Default returns the credential marked default for the given target
+// ("" matches any target), or an error if none is set.
+func (s *Store) Default(kind, target string) (Credential, error) {
+	creds, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range creds {
+		if c.KindName == kind && c.IsDefault && (target == "" || c.TargetURL == target) {
+			return c, nil
+		}
+	}
+	return nil, fmt.Errorf("auth: no default %s credential for target %q", kind, target)
+}
+
+// Add stores a new credential's metadata plus its secret, returning the
+// generated credential ID. If this is the first credential of its kind, it
+// becomes the default.
+func (s *Store) Add(kind, target string, metadata map[string]string, secret string) (string, error) {
+	if kind == "" {
+		return "", errors.New("auth: credential kind is required")
+	}
+	creds, err := s.load()
+	if err != nil {
+		return "", err
+	}
+
+	id := newUUID()
+	makeDefault := true
+	for _, c := range creds {
+		if c.KindName == kind {
+			makeDefault = false
+			break
+		}
+	}
+	creds = append(creds, StoredCredential{
+		UUID:       id,
+		KindName:   kind,
+		TargetURL:  target,
+		MetadataMp: metadata,
+		IsDefault:  makeDefault,
+	})
+	if err := s.save(creds); err != nil {
+		return "", err
+	}
+	if secret != "" {
+		if err := keyring.Set(keyringService, id, secret); err != nil {
+			return "", fmt.Errorf("auth: unable to store secret in keyring: %w", err)
+		}
+	}
+	return id, nil
+}
+
+// Secret retrieves the secret for the given credential ID from the keyring.
+func (s *Store) Secret(id string) (string, error) {
+	secret, err := keyring.Get(keyringService, id)
+	if err != nil {
+		return "", fmt.Errorf("auth: unable to load secret for credential %q: %w", id, err)
+	}
+	return secret, nil
+}
+
+// UpdateSecret rotates the keyring secret for an existing credential (e.g.
+// after an OAuth2 refresh) without touching its metadata.
+func (s *Store) UpdateSecret(id, secret string) error {
+	if _, err := s.Get(id); err != nil {
+		return err
+	}
+	if err := keyring.Set(keyringService, id, secret); err != nil {
+		return fmt.Errorf("auth: unable to update secret for credential %q: %w", id, err)
+	}
+	return nil
+}
+
+// Remove deletes a credential's metadata and its keyring secret.
+func (s *Store) Remove(id string) error {
+	creds, err := s.load()
+	if err != nil {
+		return err
+	}
+	out := creds[:0]
+	found := false
+	for _, c := range creds {
+		if c.UUID == id {
+			found = true
+			continue
+		}
+		out = append(out, c)
+	}
+	if !found {
+		return fmt.Errorf("auth: no credential with id %q", id)
+	}
+	if err := s.save(out); err != nil {
+		return err
+	}
+	if err := keyring.Delete(keyringService, id); err != nil && !errors.Is(err, keyring.ErrNotFound) {
+		return fmt.Errorf("auth: unable to remove secret from keyring: %w", err)
+	}
+	return nil
+}
+
+// SetDefault marks the credential with the given ID as the default for its
+// kind, clearing the flag on any sibling of the same kind.
+func (s *Store) SetDefault(id string) error {
+	creds, err := s.load()
+	if err != nil {
+		return err
+	}
+	var kind string
+	found := false
+	for _, c := range creds {
+		if c.UUID == id {
+			kind = c.KindName
+			found = true
+		}
+	}
+	if !found {
+		return fmt.Errorf("auth: no credential with id %q", id)
+	}
+	for i := range creds {
+		creds[i].IsDefault = creds[i].KindName == kind && creds[i].UUID == id
+	}
+	return s.save(creds)
+}
+
+func newUUID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	b[6] = (b[6] & 0x0f) | 0x40 // version 4
+	b[8] = (b[8] & 0x3f) | 0x80 // variant 10
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
@@ -0,0 +1,211 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"git-autometa/internal/auth"
+)
+
+var authCmd = &cobra.Command{
+	Use:   "auth",
+	Short: "Manage stored JIRA/GitHub credentials",
+}
+
+var authAddCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Register a new credential in the store",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runAuthAdd(cmd.InOrStdin(), cmd.OutOrStdout())
+	},
+}
+
+var authListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List stored credentials",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runAuthList(cmd.OutOrStdout())
+	},
+}
+
+var authShowCmd = &cobra.Command{
+	Use:   "show <credential-id>",
+	Short: "Show metadata for a stored credential (never prints the secret)",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runAuthShow(cmd.OutOrStdout(), args[0])
+	},
+}
+
+var authRmCmd = &cobra.Command{
+	Use:   "rm <credential-id>",
+	Short: "Remove a stored credential",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runAuthRemove(cmd.OutOrStdout(), args[0])
+	},
+}
+
+var authDefaultCmd = &cobra.Command{
+	Use:   "default <credential-id>",
+	Short: "Mark a credential as the default for its kind",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runAuthSetDefault(cmd.OutOrStdout(), args[0])
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(authCmd)
+	authCmd.AddCommand(authAddCmd, authListCmd, authShowCmd, authRmCmd, authDefaultCmd)
+}
+
+func runAuthAdd(in io.Reader, out io.Writer) error {
+	store, err := auth.NewStore()
+	if err != nil {
+		return err
+	}
+	reader := bufio.NewReader(in)
+
+	fmt.Fprintf(out, "Kind (%s, %s, %s, %s, %s, %s, %s): ",
+		auth.KindJiraBasic, auth.KindJiraOAuth1, auth.KindJiraSession, auth.KindJiraToken, auth.KindJiraOAuth2,
+		auth.KindGitHubToken, auth.KindGitHubApp)
+	kind := readString(reader)
+	switch kind {
+	case auth.KindJiraBasic, auth.KindJiraOAuth1, auth.KindJiraSession, auth.KindJiraToken, auth.KindJiraOAuth2,
+		auth.KindGitHubToken, auth.KindGitHubApp:
+	default:
+		return fmt.Errorf("auth: unknown kind %q", kind)
+	}
+
+	fmt.Fprint(out, "Target (server URL or host): ")
+	target := readString(reader)
+
+	metadata := map[string]string{}
+	switch kind {
+	case auth.KindJiraBasic:
+		fmt.Fprint(out, "Email: ")
+		metadata["email"] = readString(reader)
+	case auth.KindJiraOAuth1:
+		fmt.Fprint(out, "Consumer key: ")
+		metadata["consumer_key"] = readString(reader)
+		fmt.Fprint(out, "Private key path: ")
+		metadata["private_key_path"] = readString(reader)
+	case auth.KindJiraSession:
+		fmt.Fprint(out, "Username: ")
+		metadata["username"] = readString(reader)
+	case auth.KindJiraOAuth2:
+		fmt.Fprint(out, "Client ID: ")
+		metadata["client_id"] = readString(reader)
+		fmt.Fprint(out, "Client secret: ")
+		metadata["client_secret"] = readString(reader)
+		fmt.Fprint(out, "Token endpoint (e.g. https://auth.atlassian.com/oauth/token): ")
+		metadata["token_endpoint"] = readString(reader)
+	case auth.KindGitHubApp:
+		fmt.Fprint(out, "App slug: ")
+		metadata["app_slug"] = readString(reader)
+	}
+
+	var secret string
+	if kind == auth.KindJiraOAuth2 {
+		fmt.Fprint(out, "Access token: ")
+		access := readString(reader)
+		fmt.Fprint(out, "Refresh token: ")
+		refresh := readString(reader)
+		fmt.Fprint(out, "Expires in (seconds, enter if unknown): ")
+		var expiry time.Time
+		if s := readString(reader); s != "" {
+			if secs, err := strconv.Atoi(s); err == nil && secs > 0 {
+				expiry = time.Now().Add(time.Duration(secs) * time.Second)
+			}
+		}
+		encoded, err := auth.EncodeOAuth2Token(auth.OAuth2Token{AccessToken: access, RefreshToken: refresh, Expiry: expiry})
+		if err != nil {
+			return err
+		}
+		secret = encoded
+	} else {
+		fmt.Fprint(out, "Secret (token/password, or token:token_secret for jira-oauth1): ")
+		secret = readString(reader)
+	}
+
+	id, err := store.Add(kind, target, metadata, secret)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Added credential %s (%s, %s)\n", id, kind, target)
+	return nil
+}
+
+func runAuthList(out io.Writer) error {
+	store, err := auth.NewStore()
+	if err != nil {
+		return err
+	}
+	creds, err := store.List()
+	if err != nil {
+		return err
+	}
+	if len(creds) == 0 {
+		fmt.Fprintln(out, "No credentials stored.")
+		return nil
+	}
+	for _, c := range creds {
+		marker := ""
+		if sc, ok := c.(auth.StoredCredential); ok && sc.IsDefault {
+			marker = " (default)"
+		}
+		fmt.Fprintf(out, "%s  %-14s  %s%s\n", c.ID(), c.Kind(), c.Target(), marker)
+	}
+	return nil
+}
+
+func runAuthShow(out io.Writer, id string) error {
+	store, err := auth.NewStore()
+	if err != nil {
+		return err
+	}
+	cred, err := store.Get(id)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "ID:     %s\n", cred.ID())
+	fmt.Fprintf(out, "Kind:   %s\n", cred.Kind())
+	fmt.Fprintf(out, "Target: %s\n", cred.Target())
+	if len(cred.Metadata()) > 0 {
+		fmt.Fprintln(out, "Metadata:")
+		for k, v := range cred.Metadata() {
+			fmt.Fprintf(out, "  %s: %s\n", k, v)
+		}
+	}
+	return nil
+}
+
+func runAuthRemove(out io.Writer, id string) error {
+	store, err := auth.NewStore()
+	if err != nil {
+		return err
+	}
+	if err := store.Remove(id); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Removed credential %s\n", id)
+	return nil
+}
+
+func runAuthSetDefault(out io.Writer, id string) error {
+	store, err := auth.NewStore()
+	if err != nil {
+		return err
+	}
+	if err := store.SetDefault(id); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Credential %s is now the default for its kind\n", id)
+	return nil
+}
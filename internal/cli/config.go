@@ -15,7 +15,9 @@ import (
 	"gopkg.in/yaml.v3"
 
 	appconfig "git-autometa/internal/config"
+	"git-autometa/internal/forge"
 	"git-autometa/internal/git"
+	"git-autometa/internal/secrets"
 )
 
 const jiraKeyringService = "git-autometa-jira"
@@ -153,17 +155,41 @@ func runConfigEditGlobal(in io.Reader, out io.Writer) error {
 	if s := readString(reader); s != "" {
 		cfg.Jira.ServerURL = s
 	}
-	fmt.Fprintf(out, "JIRA email [%s]: ", cfg.Jira.Email)
-	var emailInput string
+
+	authMode := cfg.Jira.AuthMode
+	if authMode == "" {
+		authMode = appconfig.JiraAuthModeBasic
+	}
+	fmt.Fprintf(out, "JIRA auth mode (basic/session) [%s]: ", authMode)
 	if s := readString(reader); s != "" {
-		emailInput = s
-		cfg.Jira.Email = emailInput
-	} else {
-		emailInput = cfg.Jira.Email
-	}
-	// Read token (not masked in basic stdin)
-	fmt.Fprint(out, "JIRA API token [enter to skip storing]: ")
-	tokenInput := readString(reader)
+		authMode = s
+	}
+	cfg.Jira.AuthMode = authMode
+
+	var emailInput, tokenInput, usernameInput, passwordInput string
+	switch authMode {
+	case appconfig.JiraAuthModeSession:
+		fmt.Fprintf(out, "JIRA username [%s]: ", cfg.Jira.Username)
+		if s := readString(reader); s != "" {
+			usernameInput = s
+			cfg.Jira.Username = usernameInput
+		} else {
+			usernameInput = cfg.Jira.Username
+		}
+		fmt.Fprint(out, "JIRA password [enter to skip storing]: ")
+		passwordInput = readString(reader)
+	default:
+		fmt.Fprintf(out, "JIRA email [%s]: ", cfg.Jira.Email)
+		if s := readString(reader); s != "" {
+			emailInput = s
+			cfg.Jira.Email = emailInput
+		} else {
+			emailInput = cfg.Jira.Email
+		}
+		// Read token (not masked in basic stdin)
+		fmt.Fprint(out, "JIRA API token [enter to skip storing]: ")
+		tokenInput = readString(reader)
+	}
 
 	// Write global config file
 	path := appconfig.GlobalConfigPath()
@@ -178,15 +204,24 @@ func runConfigEditGlobal(in io.Reader, out io.Writer) error {
 		return err
 	}
 
-	// Store token (optional)
-	if tokenInput != "" && emailInput != "" {
-		if err := keyring.Set(jiraKeyringService, emailInput, tokenInput); err != nil {
-			return fmt.Errorf("failed saving token to keyring: %w", err)
+	// Store credentials (optional)
+	switch authMode {
+	case appconfig.JiraAuthModeSession:
+		if passwordInput != "" && usernameInput != "" {
+			if err := secrets.SetJiraSessionPassword(usernameInput, passwordInput); err != nil {
+				return fmt.Errorf("failed saving password to keyring: %w", err)
+			}
+		}
+	default:
+		if tokenInput != "" && emailInput != "" {
+			if err := keyring.Set(jiraKeyringService, emailInput, tokenInput); err != nil {
+				return fmt.Errorf("failed saving token to keyring: %w", err)
+			}
 		}
 	}
 	fmt.Fprintf(out, "Saved global configuration to %s\n", path)
-	if tokenInput != "" {
-		fmt.Fprintln(out, "Stored JIRA token in system keyring.")
+	if tokenInput != "" || passwordInput != "" {
+		fmt.Fprintln(out, "Stored JIRA credentials in system keyring.")
 	}
 	return nil
 }
@@ -285,29 +320,25 @@ func resolveOwnerRepo() (string, string) {
 }
 
 func parseGitHubOwnerRepo(remote string) (string, string, bool) {
-	s := strings.TrimSuffix(remote, ".git")
-	// Normalize ssh scp-like: git@github.com:owner/repo -> ssh://git@github.com/owner/repo
-	if strings.HasPrefix(s, "git@github.com:") {
-		s = strings.Replace(s, ":", "/", 1)
-		s = "ssh://" + s
-	}
-	// Find host separator
-	hostIdx := strings.Index(s, "github.com")
-	if hostIdx < 0 {
-		return "", "", false
-	}
-	after := s[hostIdx+len("github.com"):]
-	after = strings.TrimPrefix(after, "/")
-	parts := strings.Split(after, "/")
-	if len(parts) < 2 {
-		return "", "", false
-	}
-	owner := parts[0]
-	repo := parts[1]
+	return forge.ParseGitHubOwnerRepo(remote)
+}
+
+// resolveForgeRepo detects which forge the current repository's "origin"
+// remote belongs to (GitHub, GitLab, ...) and which owner/repo it points
+// at. It falls back to the legacy cfg.GitHub owner/repo when the remote
+// can't be parsed, so existing GitHub-only configs keep working unchanged.
+func resolveForgeRepo(cfg appconfig.Config) (forge.Repo, bool) {
+	gitUtils := git.NewFromConfig(cfg)
+	if remoteURL, err := gitUtils.GetRemoteURL("origin"); err == nil {
+		if repo, ok := forge.ResolveRepo(cfg, remoteURL); ok {
+			return repo, true
+		}
+	}
+	owner, repo := resolveOwnerRepo()
 	if owner == "" || repo == "" {
-		return "", "", false
+		return forge.Repo{}, false
 	}
-	return owner, repo, true
+	return forge.Repo{Kind: "github", Owner: owner, Name: repo}, true
 }
 
 // readString reads a line, trims whitespace and the trailing newline.
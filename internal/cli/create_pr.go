@@ -10,9 +10,10 @@ import (
 	"github.com/spf13/cobra"
 
 	appconfig "git-autometa/internal/config"
+	"git-autometa/internal/forge"
 	"git-autometa/internal/git"
-	"git-autometa/internal/github"
-	"git-autometa/internal/jira"
+	"git-autometa/internal/slug"
+	"git-autometa/internal/tracker"
 )
 
 var (
@@ -43,15 +44,23 @@ func runCreatePR(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	applyCredentialSourceOverride(&cfg)
 
-	jiraClient, err := jira.NewClientWithKeyring(cfg)
+	trk, err := tracker.New(cfg)
 	if err != nil {
 		return err
 	}
-	ghClient := github.NewClient(cfg)
-	gitUtils := git.New()
+	repo, ok := resolveForgeRepo(cfg)
+	if !ok {
+		return fmt.Errorf("unable to detect the repository's forge (GitHub/GitLab); set --owner/--repo or github.owner/github.repo in config")
+	}
+	fg, err := forge.New(cfg, repo)
+	if err != nil {
+		return err
+	}
+	gitUtils := git.NewFromConfigWithPushOptions(cfg, "", sshPushOptions())
 
-	url, err := createPRWithDeps(cfg, jiraClient, gitUtils, ghClient, baseBranch, noDraft)
+	url, err := createPRWithDeps(cfg, trk, gitUtils, fg, baseBranch, noDraft)
 	if err != nil {
 		return err
 	}
@@ -61,8 +70,16 @@ func runCreatePR(cmd *cobra.Command, args []string) error {
 
 // --- dependency injected core for testing ---
 
-type jiraGetter interface {
-	GetIssue(key string) (*jira.Issue, error)
+type issueGetter interface {
+	GetIssue(key string) (*tracker.Issue, error)
+	KeyPattern() *regexp.Regexp
+}
+
+// commenter is an optional capability: posting a comment back to the
+// issue once the PR is opened. Only JIRA implements it today, so it's
+// checked with a type assertion rather than folded into issueGetter.
+type commenter interface {
+	AddComment(key, body string) error
 }
 
 // gitContext unifies git information and commit listing needs.
@@ -71,15 +88,26 @@ type gitContext interface {
 	GetCommitMessagesForPR(baseBranch string) ([]string, error)
 }
 
-type ghCreator interface {
+// defaultBrancher is an optional capability: resolving the repository's
+// actual default branch (main, master, trunk, ...) when no base branch is
+// configured. Only the exec git.Git backend implements it today, so it's
+// checked with a type assertion rather than folded into gitContext.
+type defaultBrancher interface {
+	DefaultBranch() (string, error)
+}
+
+// forgeCreator is the narrow surface create-pr needs from a forge.Forge
+// (GitHub, GitLab, ...), kept separate so tests can fake it without
+// depending on the forge package.
+type forgeCreator interface {
 	CreatePullRequest(title, body, head, base string, draft bool) (string, error)
 }
 
 func createPRWithDeps(
 	cfg appconfig.Config,
-	jc jiraGetter,
+	jc issueGetter,
 	gu gitContext,
-	gh ghCreator,
+	gh forgeCreator,
 	overrideBase string,
 	forceNoDraft bool,
 ) (string, error) {
@@ -87,6 +115,17 @@ func createPRWithDeps(
 	if base == "" {
 		base = cfg.PullRequest.BaseBranch
 	}
+	if base == "" {
+		db, ok := gu.(defaultBrancher)
+		if !ok {
+			return "", errors.New("no base branch configured and the git backend can't detect the default branch")
+		}
+		resolved, err := db.DefaultBranch()
+		if err != nil {
+			return "", fmt.Errorf("detecting the repository's default branch: %w", err)
+		}
+		base = resolved
+	}
 	draft := cfg.PullRequest.Draft
 	if forceNoDraft {
 		draft = false
@@ -96,9 +135,9 @@ func createPRWithDeps(
 	if err != nil {
 		return "", err
 	}
-	issueKey, ok := extractIssueKeyFromBranch(headBranch)
+	issueKey, ok := extractIssueKeyFromBranch(headBranch, jc.KeyPattern())
 	if !ok {
-		return "", fmt.Errorf("unable to determine JIRA key from branch %q", headBranch)
+		return "", fmt.Errorf("unable to determine issue key from branch %q", headBranch)
 	}
 	issue, err := jc.GetIssue(issueKey)
 	if err != nil {
@@ -109,27 +148,45 @@ func createPRWithDeps(
 	if err != nil {
 		return "", err
 	}
-	return gh.CreatePullRequest(title, body, headBranch, base, draft)
+	prURL, err := gh.CreatePullRequest(title, body, headBranch, base, draft)
+	if err != nil {
+		return "", err
+	}
+
+	// Comment-back and auto-transition are optional capabilities: only
+	// trackers that implement them (currently JIRA) get this behavior.
+	if c, ok := jc.(commenter); ok {
+		if err := c.AddComment(issueKey, fmt.Sprintf("Pull request opened: %s", prURL)); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: unable to comment on %s: %v\n", issueKey, err)
+		}
+	}
+	if cfg.Jira.ReviewTransition != "" {
+		if t, ok := jc.(transitioner); ok {
+			if tid, err := t.FindTransitionByStatusName(issueKey, cfg.Jira.ReviewTransition); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: unable to transition %s to %q: %v\n", issueKey, cfg.Jira.ReviewTransition, err)
+			} else if err := t.TransitionIssue(issueKey, tid); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: unable to transition %s to %q: %v\n", issueKey, cfg.Jira.ReviewTransition, err)
+			}
+		}
+	}
+
+	return prURL, nil
 }
 
-// extractIssueKeyFromBranch finds the first occurrence of an uppercase JIRA key like ABC-123.
-func extractIssueKeyFromBranch(branch string) (string, bool) {
-	re := regexp.MustCompile(`[A-Z][A-Z0-9]+-\d+`)
-	m := re.FindString(branch)
+// extractIssueKeyFromBranch finds the first occurrence of pattern (the
+// configured tracker's issue-key syntax) in branch.
+func extractIssueKeyFromBranch(branch string, pattern *regexp.Regexp) (string, bool) {
+	m := pattern.FindString(branch)
 	if m == "" {
 		return "", false
 	}
 	return m, true
 }
 
-func formatPRTitle(cfg appconfig.Config, issue jira.Issue) string {
+func formatPRTitle(cfg appconfig.Config, issue tracker.Issue) string {
 	pattern := cfg.PullRequest.TitlePattern
-	titleSlug := issue.SlugifyTitle(0)
-	out := pattern
-	out = strings.ReplaceAll(out, "{jira_id}", issue.Key)
-	out = strings.ReplaceAll(out, "{jira_title}", titleSlug)
-	out = strings.ReplaceAll(out, "{jira_type}", strings.ToLower(strings.TrimSpace(issue.IssueType)))
-	out = strings.TrimSpace(out)
+	titleSlug := issue.SlugifyTitle(slug.Options{Separator: cfg.Git.BranchSeparator, Case: cfg.Git.BranchCase})
+	out := strings.TrimSpace(expandIssuePlaceholders(pattern, issue, titleSlug))
 	if out == "" {
 		if titleSlug != "" {
 			return fmt.Sprintf("%s: %s", issue.Key, titleSlug)
@@ -139,7 +196,7 @@ func formatPRTitle(cfg appconfig.Config, issue jira.Issue) string {
 	return out
 }
 
-func formatPRBody(cfg appconfig.Config, cl gitContext, base string, issue jira.Issue) (string, error) {
+func formatPRBody(cfg appconfig.Config, cl gitContext, base string, issue tracker.Issue) (string, error) {
 	template := cfg.PullRequest.Template
 	if template == "" {
 		return "", errors.New("empty PR template in configuration")
@@ -156,13 +213,12 @@ func formatPRBody(cfg appconfig.Config, cl gitContext, base string, issue jira.I
 			commitSection += "\n- " + msg
 		}
 	}
-	desc := issue.DescriptionMarkdown()
-	out := template
-	out = strings.ReplaceAll(out, "{jira_id}", issue.Key)
-	out = strings.ReplaceAll(out, "{jira_title}", issue.SlugifyTitle(0))
-	out = strings.ReplaceAll(out, "{jira_type}", strings.ToLower(strings.TrimSpace(issue.IssueType)))
+	titleSlug := issue.SlugifyTitle(slug.Options{Separator: cfg.Git.BranchSeparator, Case: cfg.Git.BranchCase})
+	out := expandIssuePlaceholders(template, issue, titleSlug)
+	out = strings.ReplaceAll(out, "{issue_url}", strings.TrimSpace(issue.URL))
 	out = strings.ReplaceAll(out, "{jira_url}", strings.TrimSpace(issue.URL))
-	out = strings.ReplaceAll(out, "{jira_description}", desc)
+	out = strings.ReplaceAll(out, "{issue_description}", issue.DescriptionMarkdown())
+	out = strings.ReplaceAll(out, "{jira_description}", issue.DescriptionMarkdown())
 	out = strings.ReplaceAll(out, "{commit_messages}", commitSection)
 	return strings.TrimSpace(out), nil
 }
@@ -1,30 +1,41 @@
 package cli
 
 import (
+	"regexp"
 	"strings"
 	"testing"
 
 	appconfig "git-autometa/internal/config"
-	"git-autometa/internal/jira"
+	"git-autometa/internal/tracker"
 )
 
 // --- fakes ---
 
 type fakeJiraGetter struct {
-	issue *jira.Issue
+	issue *tracker.Issue
 	err   error
 }
 
-func (f *fakeJiraGetter) GetIssue(key string) (*jira.Issue, error) {
+func (f *fakeJiraGetter) GetIssue(key string) (*tracker.Issue, error) {
 	if f.err != nil {
 		return nil, f.err
 	}
 	if f.issue != nil {
 		return f.issue, nil
 	}
-	return &jira.Issue{Key: key, Summary: "Default", IssueType: "Task"}, nil
+	return &tracker.Issue{Key: key, Summary: "Default", IssueType: "Task"}, nil
 }
 
+func (f *fakeJiraGetter) AddComment(key, body string) error { return nil }
+
+func (f *fakeJiraGetter) KeyPattern() *regexp.Regexp { return tracker.JiraKeyPattern }
+
+func (f *fakeJiraGetter) FindTransitionByStatusName(key, transitionName string) (string, error) {
+	return "1", nil
+}
+
+func (f *fakeJiraGetter) TransitionIssue(key, transitionID string) error { return nil }
+
 type fakeGitInfo struct {
 	branch  string
 	commits []string
@@ -67,7 +78,7 @@ func TestExtractIssueKeyFromBranch(t *testing.T) {
 		{"no-key-here", "", false},
 	}
 	for _, tc := range cases {
-		got, ok := extractIssueKeyFromBranch(tc.in)
+		got, ok := extractIssueKeyFromBranch(tc.in, tracker.JiraKeyPattern)
 		if ok != tc.ok || got != tc.want {
 			t.Fatalf("extractIssueKeyFromBranch(%q) = (%q,%v), want (%q,%v)", tc.in, got, ok, tc.want, tc.ok)
 		}
@@ -79,7 +90,10 @@ func TestFormatPRTitle_AndBody_Defaults(t *testing.T) {
 	cfg.PullRequest.TitlePattern = "{jira_id}: {jira_title}"
 	cfg.PullRequest.Template = "{jira_description}\n\n{commit_messages}\n\n* [{jira_id}]({jira_url})"
 
-	issue := jira.Issue{Key: "APP-7", Summary: "Add OAuth2", IssueType: "Feature", URL: "https://jira/browse/APP-7", Description: "h1. Title\n\n* a\n* b"}
+	// Description is already Markdown here: tracker adapters (e.g. the
+	// jira one) are responsible for converting their native markup before
+	// populating Issue, so formatPRBody itself does no conversion.
+	issue := tracker.Issue{Key: "APP-7", Summary: "Add OAuth2", IssueType: "Feature", URL: "https://jira/browse/APP-7", Description: "# Title\n\n- a\n- b"}
 	title := formatPRTitle(cfg, issue)
 	if !strings.HasPrefix(title, "APP-7:") {
 		t.Fatalf("unexpected title: %q", title)
@@ -91,11 +105,11 @@ func TestFormatPRTitle_AndBody_Defaults(t *testing.T) {
 	if !strings.Contains(body, "- Implement") || !strings.Contains(body, "- Tests") {
 		t.Fatalf("commit messages not rendered: %q", body)
 	}
-	if !strings.Contains(body, "# Title") { // converted heading
-		t.Fatalf("jira description not converted: %q", body)
+	if !strings.Contains(body, "# Title") {
+		t.Fatalf("issue description not rendered: %q", body)
 	}
 	if !strings.Contains(body, "[APP-7](https://jira/browse/APP-7)") {
-		t.Fatalf("jira link not present: %q", body)
+		t.Fatalf("issue link not present: %q", body)
 	}
 }
 
@@ -105,7 +119,7 @@ func TestCreatePRWithDeps_Success_Overrides(t *testing.T) {
 	cfg.PullRequest.BaseBranch = "develop"
 	cfg.PullRequest.TitlePattern = "{jira_id}: {jira_title}"
 
-	jc := &fakeJiraGetter{issue: &jira.Issue{Key: "PRJ-1", Summary: "Do thing", IssueType: "Task", URL: "u"}}
+	jc := &fakeJiraGetter{issue: &tracker.Issue{Key: "PRJ-1", Summary: "Do thing", IssueType: "Task", URL: "u"}}
 	gu := &fakeGitInfo{branch: "feature/PRJ-1-thing", commits: []string{"one"}}
 	gh := &fakeGH{}
 
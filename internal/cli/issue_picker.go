@@ -0,0 +1,283 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"git-autometa/internal/tracker"
+)
+
+// Picker selects one issue out of a candidate list, or returns a nil
+// issue if the user cancels. It's the seam between start-work's
+// issue-selection UI and its testable core: numberedPicker is what backs
+// automated tests and non-interactive runs, tuiPicker is what a
+// developer actually sees at a terminal.
+type Picker interface {
+	Pick(issues []tracker.Issue) (*tracker.Issue, error)
+}
+
+// newPicker chooses the numbered stdin/stdout prompt when in isn't an
+// interactive terminal (tests, piped input, CI) or --no-tui was passed,
+// and the full-screen fuzzy picker otherwise.
+func newPicker(jc issueService, in io.Reader, out io.Writer, noTUI bool) Picker {
+	if noTUI || !isInteractiveReader(in) {
+		return &numberedPicker{in: in, out: out}
+	}
+	return &tuiPicker{jc: jc, in: in, out: out}
+}
+
+func isInteractiveReader(in io.Reader) bool {
+	f, ok := in.(*os.File)
+	if !ok {
+		return false
+	}
+	stat, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return stat.Mode()&os.ModeCharDevice != 0
+}
+
+// numberedPicker is the original prompt: print a numbered list, read a
+// choice from in. Kept around (rather than replaced outright) because
+// it's the only picker that works over a plain io.Reader/io.Writer pair,
+// which is what the test suite and non-TTY runs (CI, piped input) use.
+type numberedPicker struct {
+	in  io.Reader
+	out io.Writer
+}
+
+func (p *numberedPicker) Pick(issues []tracker.Issue) (*tracker.Issue, error) {
+	fmt.Fprintln(p.out, "Found assigned issues:")
+	for idx, it := range issues {
+		fmt.Fprintf(p.out, " %2d. %s: %s\n", idx+1, it.Key, truncateString(it.Summary, 90))
+		if it.Status != "" || it.IssueType != "" {
+			fmt.Fprintf(p.out, "     Status: %s  Type: %s\n", it.Status, it.IssueType)
+		}
+	}
+	fmt.Fprintln(p.out, "  0. Cancel")
+
+	reader := bufio.NewReader(p.in)
+	for {
+		fmt.Fprint(p.out, "Select an issue: ")
+		choiceStr := readString(reader)
+		if choiceStr == "" {
+			continue
+		}
+		choice, convErr := strconv.Atoi(choiceStr)
+		if convErr != nil || choice < 0 || choice > len(issues) {
+			fmt.Fprintf(p.out, "Enter a number between 0 and %d\n", len(issues))
+			continue
+		}
+		if choice == 0 {
+			return nil, nil
+		}
+		selected := issues[choice-1]
+		return &selected, nil
+	}
+}
+
+// tuiPicker is a full-screen bubbletea picker: type to fuzzy-filter the
+// loaded issues by key/summary/status/assignee, arrow keys to navigate,
+// "/" to open a JQL query field that re-runs the search against the
+// tracker (debounced, so it doesn't fire on every keystroke).
+type tuiPicker struct {
+	jc  issueService
+	in  io.Reader
+	out io.Writer
+}
+
+func (p *tuiPicker) Pick(issues []tracker.Issue) (*tracker.Issue, error) {
+	prog := tea.NewProgram(newPickerModel(issues, p.jc), tea.WithInput(p.in), tea.WithOutput(p.out))
+	final, err := prog.Run()
+	if err != nil {
+		return nil, err
+	}
+	m := final.(pickerModel)
+	if m.cancelled || m.selected == nil {
+		return nil, nil
+	}
+	return m.selected, nil
+}
+
+// jqlDebounce is how long the picker waits after the last keystroke in
+// the JQL field before actually querying the tracker.
+const jqlDebounce = 300 * time.Millisecond
+
+// issueItem adapts a tracker.Issue to list.Item. It wraps rather than
+// aliases tracker.Issue, since that struct's own Description field would
+// otherwise collide with the Description() method list.DefaultItem
+// requires.
+type issueItem struct {
+	issue tracker.Issue
+}
+
+func (i issueItem) Title() string { return fmt.Sprintf("%s: %s", i.issue.Key, i.issue.Summary) }
+func (i issueItem) Description() string {
+	return fmt.Sprintf("Status: %s  Type: %s  Assignee: %s", i.issue.Status, i.issue.IssueType, i.issue.Assignee)
+}
+func (i issueItem) FilterValue() string {
+	return strings.Join([]string{i.issue.Key, i.issue.Summary, i.issue.Status, i.issue.Assignee}, " ")
+}
+
+type pickerModel struct {
+	list list.Model
+	jc   issueService
+
+	all    []tracker.Issue // most recent full result set: the initial search, or the last JQL run
+	filter string          // local fuzzy filter typed directly over all
+
+	querying bool
+	query    textinput.Model
+	queryGen int
+	queryErr string
+
+	selected  *tracker.Issue
+	cancelled bool
+}
+
+func newPickerModel(issues []tracker.Issue, jc issueService) pickerModel {
+	l := list.New(toItems(issues, ""), list.NewDefaultDelegate(), 0, 0)
+	l.Title = "Select an issue (type to filter, \"/\" for a JQL query, esc to cancel)"
+
+	ti := textinput.New()
+	ti.Placeholder = `project = APP AND status = "In Progress"`
+	ti.Prompt = "jql> "
+
+	return pickerModel{list: l, jc: jc, all: issues, query: ti}
+}
+
+// toItems fuzzy-filters issues by query (a subsequence match against
+// FilterValue, case-insensitive); an empty query matches everything.
+func toItems(issues []tracker.Issue, query string) []list.Item {
+	items := make([]list.Item, 0, len(issues))
+	for _, it := range issues {
+		item := issueItem{issue: it}
+		if query == "" || fuzzyMatch(query, item.FilterValue()) {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func fuzzyMatch(pattern, s string) bool {
+	runes := []rune(strings.ToLower(pattern))
+	s = strings.ToLower(s)
+	i := 0
+	for _, r := range s {
+		if i < len(runes) && runes[i] == r {
+			i++
+		}
+	}
+	return i == len(runes)
+}
+
+func (m pickerModel) Init() tea.Cmd { return nil }
+
+// jqlResultMsg carries a JQL search result back from searchAfterDebounce.
+// gen lets Update discard results from a query superseded by later
+// keystrokes.
+type jqlResultMsg struct {
+	gen    int
+	issues []tracker.Issue
+	err    error
+}
+
+func searchAfterDebounce(jc issueService, query string, gen int) tea.Cmd {
+	return tea.Tick(jqlDebounce, func(time.Time) tea.Msg {
+		searcher, ok := jc.(tracker.JQLSearcher)
+		if !ok {
+			return jqlResultMsg{gen: gen, err: fmt.Errorf("picker: current tracker doesn't support JQL search")}
+		}
+		issues, err := searcher.SearchIssues(query, 50)
+		return jqlResultMsg{gen: gen, issues: issues, err: err}
+	})
+}
+
+func (m pickerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.list.SetSize(msg.Width, msg.Height-1)
+		return m, nil
+
+	case jqlResultMsg:
+		if msg.gen != m.queryGen {
+			return m, nil // stale: a later keystroke already started a newer query
+		}
+		if msg.err != nil {
+			m.queryErr = msg.err.Error()
+			return m, nil
+		}
+		m.queryErr = ""
+		m.all = msg.issues
+		m.filter = ""
+		m.list.SetItems(toItems(m.all, ""))
+		return m, nil
+
+	case tea.KeyMsg:
+		if m.querying {
+			switch msg.Type {
+			case tea.KeyEsc, tea.KeyEnter:
+				m.querying = false
+				m.query.Blur()
+				return m, nil
+			default:
+				var cmd tea.Cmd
+				m.query, cmd = m.query.Update(msg)
+				m.queryGen++
+				return m, tea.Batch(cmd, searchAfterDebounce(m.jc, m.query.Value(), m.queryGen))
+			}
+		}
+
+		switch msg.Type {
+		case tea.KeyCtrlC, tea.KeyEsc:
+			m.cancelled = true
+			return m, tea.Quit
+		case tea.KeyEnter:
+			if it, ok := m.list.SelectedItem().(issueItem); ok {
+				sel := it.issue
+				m.selected = &sel
+			}
+			return m, tea.Quit
+		case tea.KeyBackspace:
+			if len(m.filter) > 0 {
+				r := []rune(m.filter)
+				m.filter = string(r[:len(r)-1])
+				m.list.SetItems(toItems(m.all, m.filter))
+			}
+			return m, nil
+		case tea.KeyRunes:
+			if string(msg.Runes) == "/" {
+				m.querying = true
+				m.query.Focus()
+				return m, textinput.Blink
+			}
+			m.filter += string(msg.Runes)
+			m.list.SetItems(toItems(m.all, m.filter))
+			return m, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	m.list, cmd = m.list.Update(msg)
+	return m, cmd
+}
+
+func (m pickerModel) View() string {
+	if m.querying {
+		return m.list.View() + "\n" + m.query.View()
+	}
+	if m.queryErr != "" {
+		return m.list.View() + "\n" + m.queryErr
+	}
+	return m.list.View()
+}
@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"git-autometa/internal/tracker"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPicker_NonTTYReader_ReturnsNumberedPicker(t *testing.T) {
+	// bytes.Buffer is never an interactive terminal, so this must hold
+	// regardless of noTUI, matching the numbered-prompt tests in
+	// start_work_test.go that rely on it.
+	p := newPicker(&fakeJira{}, bytes.NewBufferString("1\n"), &bytes.Buffer{}, false)
+	_, ok := p.(*numberedPicker)
+	assert.True(t, ok, "expected numberedPicker for a non-TTY reader")
+}
+
+func TestNewPicker_NoTUIFlag_ReturnsNumberedPicker(t *testing.T) {
+	p := newPicker(&fakeJira{}, bytes.NewBufferString("1\n"), &bytes.Buffer{}, true)
+	_, ok := p.(*numberedPicker)
+	assert.True(t, ok, "expected numberedPicker when --no-tui is set")
+}
+
+func TestNumberedPicker_SelectAndCancel(t *testing.T) {
+	issues := []tracker.Issue{
+		{Key: "P-1", Summary: "First"},
+		{Key: "P-2", Summary: "Second"},
+	}
+
+	var out bytes.Buffer
+	p := &numberedPicker{in: bytes.NewBufferString("2\n"), out: &out}
+	got, err := p.Pick(issues)
+	assert.NoError(t, err)
+	if assert.NotNil(t, got) {
+		assert.Equal(t, "P-2", got.Key)
+	}
+
+	p = &numberedPicker{in: bytes.NewBufferString("0\n"), out: &out}
+	got, err = p.Pick(issues)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestFuzzyMatch(t *testing.T) {
+	cases := []struct {
+		pattern, s string
+		want       bool
+	}{
+		{"app7", "APP-7: Add OAuth2", true},
+		{"oauth", "APP-7: Add OAuth2", true},
+		{"xyz", "APP-7: Add OAuth2", false},
+		{"", "anything", true},
+		{"café", "Fix café menu crash", true},
+	}
+	for _, tc := range cases {
+		assert.Equalf(t, tc.want, fuzzyMatch(tc.pattern, tc.s), "fuzzyMatch(%q, %q)", tc.pattern, tc.s)
+	}
+}
@@ -0,0 +1,23 @@
+package cli
+
+import (
+	"strings"
+
+	"git-autometa/internal/tracker"
+)
+
+// expandIssuePlaceholders substitutes "{issue_id}", "{issue_title}" and
+// "{issue_type}" in s with fields from issue. The older "{jira_id}",
+// "{jira_title}" and "{jira_type}" spellings are kept as aliases for
+// configs written before trackers other than JIRA existed.
+func expandIssuePlaceholders(s string, issue tracker.Issue, titleSlug string) string {
+	repl := strings.NewReplacer(
+		"{issue_id}", issue.Key,
+		"{jira_id}", issue.Key,
+		"{issue_title}", titleSlug,
+		"{jira_title}", titleSlug,
+		"{issue_type}", strings.ToLower(issue.IssueType),
+		"{jira_type}", strings.ToLower(issue.IssueType),
+	)
+	return repl.Replace(s)
+}
@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"git-autometa/internal/jira"
+)
+
+var jiraCmd = &cobra.Command{
+	Use:   "jira",
+	Short: "Interact directly with a JIRA issue",
+}
+
+var jiraTransitionCmd = &cobra.Command{
+	Use:          "transition <JIRA-KEY> <name>",
+	Short:        "Move a JIRA issue to the named workflow status",
+	Args:         cobra.ExactArgs(2),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runJiraTransition(args[0], args[1], cmd.OutOrStdout())
+	},
+}
+
+var jiraCommentCmd = &cobra.Command{
+	Use:          "comment <JIRA-KEY>",
+	Short:        "Add a comment to a JIRA issue, read from stdin",
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runJiraComment(args[0], cmd.InOrStdin(), cmd.OutOrStdout())
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(jiraCmd)
+	jiraCmd.AddCommand(jiraTransitionCmd, jiraCommentCmd)
+}
+
+func runJiraTransition(key, transitionName string, out io.Writer) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	applyCredentialSourceOverride(&cfg)
+	jc, err := jira.NewClientWithKeyring(cfg)
+	if err != nil {
+		return err
+	}
+	return transitionIssue(jc, key, transitionName, out)
+}
+
+func runJiraComment(key string, in io.Reader, out io.Writer) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	applyCredentialSourceOverride(&cfg)
+	jc, err := jira.NewClientWithKeyring(cfg)
+	if err != nil {
+		return err
+	}
+
+	body, err := readAllTrimmed(in)
+	if err != nil {
+		return err
+	}
+	if body == "" {
+		return fmt.Errorf("jira: empty comment body")
+	}
+	if err := jc.AddComment(key, body); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Commented on %s\n", key)
+	return nil
+}
+
+func readAllTrimmed(in io.Reader) (string, error) {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
+	var lines []string
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return joinTrimmed(lines), nil
+}
+
+func joinTrimmed(lines []string) string {
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	out := lines[0]
+	for _, l := range lines[1:] {
+		out += "\n" + l
+	}
+	return out
+}
@@ -0,0 +1,149 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	appconfig "git-autometa/internal/config"
+	"git-autometa/internal/jira"
+	"git-autometa/internal/secrets"
+)
+
+var oauthSetupCmd = &cobra.Command{
+	Use:   "oauth-setup",
+	Short: "Register an OAuth 1.0a Application Link and perform the JIRA Server handshake",
+	Long: `oauth-setup walks through connecting git-autometa to a self-hosted JIRA Server
+instance using three-legged OAuth 1.0a:
+
+  1. Generates (or reuses) an RSA key pair.
+  2. Prints the public key and a consumer key for you to register as an
+     Application Link in JIRA (Administration > Applications > Application Links).
+  3. Requests a request token, opens your browser to authorize it, and
+     exchanges the verifier you paste back for an access token.
+  4. Stores the access token pair in the system keyring and AuthMode: oauth1
+     in the global config.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runOAuthSetup(cmd.InOrStdin(), cmd.OutOrStdout())
+	},
+}
+
+func init() {
+	configCmd.AddCommand(oauthSetupCmd)
+}
+
+func runOAuthSetup(in io.Reader, out io.Writer) error {
+	cfg, err := appconfig.LoadEffectiveConfig(cfgPath)
+	if err != nil {
+		return err
+	}
+	reader := bufio.NewReader(in)
+
+	fmt.Fprintf(out, "JIRA server URL [%s]: ", cfg.Jira.ServerURL)
+	if s := readString(reader); s != "" {
+		cfg.Jira.ServerURL = s
+	}
+	if cfg.Jira.ServerURL == "" {
+		return fmt.Errorf("jira server URL is required")
+	}
+
+	keyPath := cfg.Jira.PrivateKeyPath
+	if keyPath == "" {
+		keyPath = filepath.Join(filepath.Dir(appconfig.GlobalConfigPath()), "jira-oauth1.pem")
+	}
+	key, err := loadOrGenerateOAuth1Key(keyPath)
+	if err != nil {
+		return err
+	}
+	cfg.Jira.PrivateKeyPath = keyPath
+
+	fmt.Fprintf(out, "\nPublic key (register this under the Application Link's consumer info):\n\n%s\n", key.EncodePublicKeyPEM())
+
+	fmt.Fprintf(out, "Consumer key [%s]: ", cfg.Jira.ConsumerKey)
+	if s := readString(reader); s != "" {
+		cfg.Jira.ConsumerKey = s
+	}
+	if cfg.Jira.ConsumerKey == "" {
+		return fmt.Errorf("consumer key is required")
+	}
+
+	requestToken, _, err := jira.FetchRequestToken(cfg.Jira.ServerURL, cfg.Jira.ConsumerKey, key)
+	if err != nil {
+		return fmt.Errorf("oauth-setup: request token step failed: %w", err)
+	}
+
+	authorizeURL := jira.AuthorizeURL(cfg.Jira.ServerURL, requestToken)
+	fmt.Fprintf(out, "\nOpen this URL to authorize git-autometa (attempting to open your browser):\n%s\n\n", authorizeURL)
+	_ = openBrowser(authorizeURL)
+
+	fmt.Fprint(out, "Paste the verifier code shown after authorizing: ")
+	verifier := readString(reader)
+	if verifier == "" {
+		return fmt.Errorf("oauth-setup: no verifier provided")
+	}
+
+	accessToken, accessSecret, err := jira.FetchAccessToken(cfg.Jira.ServerURL, cfg.Jira.ConsumerKey, key, requestToken, verifier)
+	if err != nil {
+		return fmt.Errorf("oauth-setup: access token step failed: %w", err)
+	}
+
+	if err := secrets.SetJiraOAuth1Token(cfg.Jira.ConsumerKey, accessToken, accessSecret); err != nil {
+		return fmt.Errorf("oauth-setup: failed saving access token to keyring: %w", err)
+	}
+
+	cfg.Jira.AuthMode = appconfig.JiraAuthModeOAuth1
+	path := appconfig.GlobalConfigPath()
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "\nStored OAuth 1.0a access token in the system keyring and saved AuthMode: oauth1 to %s\n", path)
+	return nil
+}
+
+// loadOrGenerateOAuth1Key reads the RSA private key at path, generating and
+// persisting a new one if it doesn't exist yet.
+func loadOrGenerateOAuth1Key(path string) (*jira.OAuth1Key, error) {
+	if data, err := os.ReadFile(path); err == nil {
+		return jira.LoadOAuth1Key(data)
+	}
+	key, err := jira.GenerateOAuth1Key()
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(path, key.EncodePrivateKeyPEM(), 0o600); err != nil {
+		return nil, fmt.Errorf("oauth-setup: unable to persist RSA key: %w", err)
+	}
+	return key, nil
+}
+
+// openBrowser best-effort opens url in the user's default browser.
+func openBrowser(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	return cmd.Start()
+}
@@ -5,12 +5,27 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+
+	appconfig "git-autometa/internal/config"
+	"git-autometa/internal/git"
+	"git-autometa/internal/secrets"
 )
 
 var (
 	verbose   bool
 	repoOwner string
 	repoName  string
+
+	// credentialSource forces secrets.GetJiraToken to use exactly one
+	// provider in its keyring/git-credential/netrc/env chain, for
+	// debugging which source is actually supplying a token. Empty tries
+	// the full chain.
+	credentialSource string
+
+	// sshKeyPath pins PushBranch to a specific SSH private key instead of
+	// whatever the ambient ssh-agent/~/.ssh/config would pick. Empty
+	// leaves push authentication entirely to the environment.
+	sshKeyPath string
 )
 
 var rootCmd = &cobra.Command{
@@ -31,4 +46,31 @@ func init() {
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Enable verbose output")
 	rootCmd.PersistentFlags().StringVar(&repoOwner, "owner", "", "Repository owner (defaults to current git remote)")
 	rootCmd.PersistentFlags().StringVar(&repoName, "repo", "", "Repository name (defaults to current git remote)")
+	rootCmd.PersistentFlags().StringVar(&credentialSource, "credential-source", "",
+		"Force a single Jira credential source for debugging (keyring, git-credential, netrc, env)")
+	rootCmd.PersistentFlags().StringVar(&sshKeyPath, "ssh-key", "",
+		"Path to a specific SSH private key to push with (overrides the ambient ssh-agent/config)")
+}
+
+// applyCredentialSourceOverride sets cfg.Auth.CredentialSource from the
+// global --credential-source flag, when set.
+func applyCredentialSourceOverride(cfg *appconfig.Config) {
+	if credentialSource != "" {
+		cfg.Auth.CredentialSource = credentialSource
+	}
+}
+
+// sshPushOptions builds the git.PushOptions for the global --ssh-key flag,
+// looking up a stored passphrase for the key (if any) from the secrets
+// keyring. Returns a zero value when --ssh-key isn't set, leaving push
+// authentication entirely to the ambient environment.
+func sshPushOptions() git.PushOptions {
+	if sshKeyPath == "" {
+		return git.PushOptions{}
+	}
+	passphrase, err := secrets.GetSSHKeyPassphrase(sshKeyPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: unable to look up passphrase for %s: %v\n", sshKeyPath, err)
+	}
+	return git.PushOptions{SSHKeyPath: sshKeyPath, SSHKeyPassphrase: passphrase}
 }
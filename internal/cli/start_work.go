@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"io"
 	"os"
-	"strconv"
 	"strings"
 	"unicode"
 
@@ -14,11 +13,13 @@ import (
 
 	appconfig "git-autometa/internal/config"
 	"git-autometa/internal/git"
-	"git-autometa/internal/jira"
+	"git-autometa/internal/slug"
+	"git-autometa/internal/tracker"
 )
 
 var (
-	pushFlag bool
+	pushFlag  bool
+	noTUIFlag bool
 )
 
 var startWorkCmd = &cobra.Command{
@@ -36,15 +37,25 @@ var startWorkCmd = &cobra.Command{
 
 func init() {
 	startWorkCmd.Flags().BoolVar(&pushFlag, "push", false, "Push the branch after creation")
+	startWorkCmd.Flags().BoolVar(&noTUIFlag, "no-tui", false, "Use the numbered-list issue picker instead of the full-screen fuzzy picker")
 	rootCmd.AddCommand(startWorkCmd)
 }
 
 // selectIssueInteractively lists assigned issues and lets the user pick one.
 // Returns nil if user cancels.
-// Narrow Jira interface for testability
-type jiraService interface {
-	SearchMyIssues(limit int) ([]jira.Issue, error)
-	GetIssue(key string) (*jira.Issue, error)
+// Narrow tracker interface for testability
+type issueService interface {
+	SearchMyIssues(limit int) ([]tracker.Issue, error)
+	GetIssue(key string) (*tracker.Issue, error)
+}
+
+// transitioner is an optional capability: moving an issue through its
+// workflow. Only JIRA implements it today, so it's checked with a type
+// assertion rather than folded into issueService, which every tracker
+// backend must satisfy.
+type transitioner interface {
+	FindTransitionByStatusName(key, transitionName string) (string, error)
+	TransitionIssue(key, transitionID string) error
 }
 
 // Narrow Git interface for testability
@@ -53,7 +64,7 @@ type gitService interface {
 	PushBranch(branchName string) error
 }
 
-func selectIssueInteractively(jc jiraService, in io.Reader, out io.Writer) (*jira.Issue, error) {
+func selectIssueInteractively(jc issueService, in io.Reader, out io.Writer, noTUI bool) (*tracker.Issue, error) {
 	issues, err := jc.SearchMyIssues(15)
 	if err != nil || len(issues) == 0 {
 		// Fallback: manual entry
@@ -75,51 +86,28 @@ func selectIssueInteractively(jc jiraService, in io.Reader, out io.Writer) (*jir
 		return issue, nil
 	}
 
-	// Show list
-	fmt.Fprintln(out, "Found assigned issues:")
-	for idx, it := range issues {
-		fmt.Fprintf(out, " %2d. %s: %s\n", idx+1, it.Key, truncateString(it.Summary, 90))
-		if it.Status != "" || it.IssueType != "" {
-			fmt.Fprintf(out, "     Status: %s  Type: %s\n", it.Status, it.IssueType)
-		}
-	}
-	fmt.Fprintln(out, "  0. Cancel")
-
-	// Prompt
-	reader := bufio.NewReader(in)
-	for {
-		fmt.Fprint(out, "Select an issue: ")
-		choiceStr := readString(reader)
-		if choiceStr == "" {
-			continue
-		}
-		choice, convErr := strconv.Atoi(choiceStr)
-		if convErr != nil || choice < 0 || choice > len(issues) {
-			fmt.Fprintf(out, "Enter a number between 0 and %d\n", len(issues))
-			continue
-		}
-		if choice == 0 {
-			return nil, nil
-		}
-		// Convert to zero-based index
-		selected := issues[choice-1]
-		// Fetch full issue to get description and canonical URL if needed
-		return jc.GetIssue(selected.Key)
+	selected, err := newPicker(jc, in, out, noTUI).Pick(issues)
+	if err != nil || selected == nil {
+		return selected, err
 	}
+	// Fetch full issue to get description and canonical URL if needed
+	return jc.GetIssue(selected.Key)
 }
 
-func formatBranchName(cfg appconfig.Config, issue jira.Issue) string {
+func formatBranchName(cfg appconfig.Config, issue tracker.Issue) string {
 	pattern := cfg.Git.BranchPattern
 	maxLen := cfg.Git.MaxBranchLength
-	titleSlug := issue.SlugifyTitle(maxLen)
-	branch := strings.ReplaceAll(pattern, "{jira_id}", issue.Key)
-	branch = strings.ReplaceAll(branch, "{jira_title}", titleSlug)
-	branch = strings.ReplaceAll(branch, "{jira_type}", strings.ToLower(issue.IssueType))
-	branch = sanitizeBranchName(branch)
+	sep := cfg.Git.BranchSeparator
+	if sep == "" {
+		sep = "-"
+	}
+	titleSlug := issue.SlugifyTitle(slug.Options{Separator: sep, Case: cfg.Git.BranchCase})
+	branch := expandIssuePlaceholders(pattern, issue, titleSlug)
+	branch = sanitizeBranchName(branch, sep)
 	if maxLen > 0 && len(branch) > maxLen {
 		branch = branch[:maxLen]
+		branch = strings.Trim(branch, sep)
 	}
-	branch = strings.Trim(branch, "-")
 	return branch
 }
 
@@ -128,33 +116,34 @@ func runStartWork(args []string) error {
 	if err != nil {
 		return err
 	}
+	applyCredentialSourceOverride(&cfg)
 
-	jiraClient, err := jira.NewClientWithKeyring(cfg)
+	trk, err := tracker.New(cfg)
 	if err != nil {
 		return err
 	}
-	gitUtils := git.Git{MainBranch: cfg.PullRequest.BaseBranch}
-	return startWorkWithDeps(args, cfg, jiraClient, gitUtils, os.Stdin, os.Stdout, pushFlag)
+	gitUtils := git.NewFromConfigWithOptions(cfg, "", cfg.PullRequest.BaseBranch, sshPushOptions())
+	return startWorkWithDeps(args, cfg, trk, gitUtils, os.Stdin, os.Stdout, pushFlag, noTUIFlag)
 }
 
 // startWorkWithDeps contains the testable core logic.
-func startWorkWithDeps(args []string, cfg appconfig.Config, jc jiraService, gu gitService, in io.Reader, out io.Writer, push bool) error {
+func startWorkWithDeps(args []string, cfg appconfig.Config, jc issueService, gu gitService, in io.Reader, out io.Writer, push, noTUI bool) error {
 	// Resolve the issue either from CLI arg or interactively
 	var (
-		selectedIssue *jira.Issue
+		selectedIssue *tracker.Issue
 		err           error
 	)
 	if len(args) == 1 {
 		issueKey := args[0]
 		if issueKey == "" {
-			return errors.New("empty JIRA key provided")
+			return errors.New("empty issue key provided")
 		}
 		selectedIssue, err = jc.GetIssue(issueKey)
 		if err != nil {
 			return err
 		}
 	} else {
-		selectedIssue, err = selectIssueInteractively(jc, in, out)
+		selectedIssue, err = selectIssueInteractively(jc, in, out, noTUI)
 		if err != nil {
 			return err
 		}
@@ -180,6 +169,16 @@ func startWorkWithDeps(args []string, cfg appconfig.Config, jc jiraService, gu g
 		}
 	}
 
+	// Auto-transition the issue to its "start work" status if configured.
+	// Only trackers that implement transitioner (currently JIRA) support this.
+	if cfg.Jira.StartTransition != "" {
+		if t, ok := jc.(transitioner); ok {
+			if err := transitionIssue(t, selectedIssue.Key, cfg.Jira.StartTransition, out); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: unable to transition %s to %q: %v\n", selectedIssue.Key, cfg.Jira.StartTransition, err)
+			}
+		}
+	}
+
 	// Output
 	fmt.Fprintf(out, "Ready on branch: %s\n", finalBranchName)
 	fmt.Fprintf(out, "Issue: %s - %s\n", selectedIssue.Key, selectedIssue.Summary)
@@ -189,9 +188,25 @@ func startWorkWithDeps(args []string, cfg appconfig.Config, jc jiraService, gu g
 	return nil
 }
 
-// sanitizeBranchName performs minimal cleanup to ensure a safe git branch name.
-func sanitizeBranchName(s string) string {
-	// Replace any non-alphanumeric character with a dash using a whitelist approach
+// transitionIssue resolves transitionName to a transition ID on key and
+// applies it, printing a confirmation to out on success.
+func transitionIssue(jc transitioner, key, transitionName string, out io.Writer) error {
+	id, err := jc.FindTransitionByStatusName(key, transitionName)
+	if err != nil {
+		return err
+	}
+	if err := jc.TransitionIssue(key, id); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Transitioned %s to %q\n", key, transitionName)
+	return nil
+}
+
+// sanitizeBranchName cleans up an assembled branch name: any
+// non-alphanumeric rune becomes sep, runs of sep collapse to one, and
+// the result is run through slug.SanitizeRef to satisfy git's
+// check-ref-format rules.
+func sanitizeBranchName(s, sep string) string {
 	var b strings.Builder
 	b.Grow(len(s))
 	for _, r := range s {
@@ -199,9 +214,9 @@ func sanitizeBranchName(s string) string {
 			b.WriteRune(r)
 			continue
 		}
-		b.WriteByte('-')
+		b.WriteString(sep)
 	}
-	return strings.Trim(b.String(), "-")
+	return slug.SanitizeRef(strings.Trim(b.String(), sep), sep)
 }
 
 func truncateString(s string, max int) string {
@@ -5,7 +5,7 @@ import (
 	"testing"
 
 	appconfig "git-autometa/internal/config"
-	"git-autometa/internal/jira"
+	"git-autometa/internal/tracker"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -16,7 +16,23 @@ func TestFormatBranchName_DefaultPattern(t *testing.T) {
 	cfg.Git.BranchPattern = "feature/{jira_id}-{jira_title}"
 	cfg.Git.MaxBranchLength = 100
 
-	issue := jira.Issue{
+	issue := tracker.Issue{
+		Key:       "PROJ-123",
+		Summary:   "Fix Login Validation Bug",
+		IssueType: "Bug",
+	}
+
+	got := formatBranchName(cfg, issue)
+	want := "feature-PROJ-123-fix-login-validation-bug"
+	assert.Equal(t, want, got)
+}
+
+func TestFormatBranchName_NewPlaceholders(t *testing.T) {
+	cfg := appconfig.DefaultConfig()
+	cfg.Git.BranchPattern = "feature/{issue_id}-{issue_title}"
+	cfg.Git.MaxBranchLength = 100
+
+	issue := tracker.Issue{
 		Key:       "PROJ-123",
 		Summary:   "Fix Login Validation Bug",
 		IssueType: "Bug",
@@ -32,7 +48,7 @@ func TestFormatBranchName_CustomPatternAndType(t *testing.T) {
 	cfg.Git.BranchPattern = "{jira_type}/{jira_id}"
 	cfg.Git.MaxBranchLength = 100
 
-	issue := jira.Issue{
+	issue := tracker.Issue{
 		Key:       "APP-7",
 		Summary:   "Add OAuth2",
 		IssueType: "Feature",
@@ -48,7 +64,7 @@ func TestFormatBranchName_MaxLength(t *testing.T) {
 	cfg.Git.BranchPattern = "feature/{jira_id}-{jira_title}"
 	cfg.Git.MaxBranchLength = 20
 
-	issue := jira.Issue{
+	issue := tracker.Issue{
 		Key:     "PROJ-10",
 		Summary: "This is a very long title that should be truncated",
 	}
@@ -59,7 +75,7 @@ func TestFormatBranchName_MaxLength(t *testing.T) {
 
 func TestSanitizeBranchName_RemovesDisallowedAndCollapses(t *testing.T) {
 	in := "feat//weird^name..with*[chars]? and spaces"
-	got := sanitizeBranchName(in)
+	got := sanitizeBranchName(in, "-")
 	// Ensure forbidden tokens replaced and multiple dashes/slashes collapsed
 	assert.NotEqual(t, in, got)
 	assert.False(t, containsAny(got, []string{"^", ":", "?", "*", "[", ".."}))
@@ -87,22 +103,28 @@ func indexOf(s, substr string) int {
 // --- fakes for testing startWorkWithDeps ---
 
 type fakeJira struct {
-	issues []jira.Issue
-	issue  *jira.Issue
+	issues []tracker.Issue
+	issue  *tracker.Issue
 	err    error
 }
 
-func (f *fakeJira) SearchMyIssues(limit int) ([]jira.Issue, error) { return f.issues, f.err }
-func (f *fakeJira) GetIssue(key string) (*jira.Issue, error) {
+func (f *fakeJira) SearchMyIssues(limit int) ([]tracker.Issue, error) { return f.issues, f.err }
+func (f *fakeJira) GetIssue(key string) (*tracker.Issue, error) {
 	if f.err != nil {
 		return nil, f.err
 	}
 	if f.issue != nil {
 		return f.issue, nil
 	}
-	return &jira.Issue{Key: key, Summary: "S", IssueType: "Task"}, nil
+	return &tracker.Issue{Key: key, Summary: "S", IssueType: "Task"}, nil
+}
+
+func (f *fakeJira) FindTransitionByStatusName(key, transitionName string) (string, error) {
+	return "1", nil
 }
 
+func (f *fakeJira) TransitionIssue(key, transitionID string) error { return nil }
+
 type fakeGit struct {
 	prepared string
 	pushed   string
@@ -126,11 +148,11 @@ func TestStartWorkWithDeps_ArgFlow_NoPush(t *testing.T) {
 	cfg.Git.BranchPattern = "feature/{jira_id}-{jira_title}"
 	cfg.Git.MaxBranchLength = 60
 
-	fj := &fakeJira{issue: &jira.Issue{Key: "P-1", Summary: "Login fix", IssueType: "Bug"}}
+	fj := &fakeJira{issue: &tracker.Issue{Key: "P-1", Summary: "Login fix", IssueType: "Bug"}}
 	fg := &fakeGit{}
 
 	var out bytes.Buffer
-	err := startWorkWithDeps([]string{"P-1"}, cfg, fj, fg, bytes.NewBuffer(nil), &out, false)
+	err := startWorkWithDeps([]string{"P-1"}, cfg, fj, fg, bytes.NewBuffer(nil), &out, false, false)
 	require.NoError(t, err)
 	assert.Equal(t, "feature-P-1-login-fix", fg.prepared)
 	assert.Empty(t, fg.pushed)
@@ -141,14 +163,14 @@ func TestStartWorkWithDeps_Interactive_Push(t *testing.T) {
 	cfg := appconfig.DefaultConfig()
 	cfg.Git.BranchPattern = "{jira_id}"
 
-	fj := &fakeJira{issues: []jira.Issue{{Key: "X-7", Summary: "Do it"}}}
+	fj := &fakeJira{issues: []tracker.Issue{{Key: "X-7", Summary: "Do it"}}}
 	fg := &fakeGit{}
 
 	// Simulate selecting first issue (enter "1")
 	in := bytes.NewBufferString("1\n")
 	var out bytes.Buffer
 
-	err := startWorkWithDeps(nil, cfg, fj, fg, in, &out, true)
+	err := startWorkWithDeps(nil, cfg, fj, fg, in, &out, true, false)
 	require.NoError(t, err)
 	assert.Equal(t, "X-7", fg.prepared)
 	assert.Equal(t, "X-7", fg.pushed)
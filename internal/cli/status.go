@@ -7,8 +7,8 @@ import (
 	"github.com/spf13/cobra"
 
 	appconfig "git-autometa/internal/config"
+	"git-autometa/internal/forge"
 	"git-autometa/internal/git"
-	"git-autometa/internal/github"
 	"git-autometa/internal/jira"
 	"git-autometa/internal/secrets"
 )
@@ -35,10 +35,11 @@ func runStatus(cmd *cobra.Command, _ []string) error {
 	if err != nil {
 		return err
 	}
+	applyCredentialSourceOverride(&cfg)
 
 	// Repository info
 	fmt.Println("Repository:")
-	gitUtils := git.New()
+	gitUtils := git.NewFromConfig(cfg)
 	if branch, err := gitUtils.GetCurrentBranch(); err == nil {
 		fmt.Printf("  Current branch: %s\n", branch)
 	} else {
@@ -51,10 +52,11 @@ func runStatus(cmd *cobra.Command, _ []string) error {
 	}
 
 	owner, repo := resolveOwnerRepo()
-	if owner != "" && repo != "" {
-		fmt.Printf("  GitHub repository: %s/%s\n", owner, repo)
+	forgeRepo, forgeDetected := resolveForgeRepo(cfg)
+	if forgeDetected {
+		fmt.Printf("  %s repository: %s/%s\n", forge.DisplayName(forgeRepo.Kind), forgeRepo.Owner, forgeRepo.Name)
 	} else {
-		fmt.Println("  GitHub repository: (not detected)")
+		fmt.Println("  Forge repository: (not detected)")
 	}
 
 	// Configuration paths
@@ -86,7 +88,7 @@ func runStatus(cmd *cobra.Command, _ []string) error {
 	// Jira token presence (do not print token)
 	jiraTokenStatus := "not configured"
 	if cfg.Jira.Email != "" {
-		if _, err := secrets.GetJiraToken(cfg.Jira.Email); err == nil {
+		if _, err := secrets.GetJiraToken(cfg); err == nil {
 			jiraTokenStatus = "present"
 		} else {
 			jiraTokenStatus = "missing"
@@ -94,12 +96,18 @@ func runStatus(cmd *cobra.Command, _ []string) error {
 	}
 	fmt.Printf("  Jira token: %s\n", jiraTokenStatus)
 
-	// GitHub CLI authentication
-	ghClient := github.NewClient(cfg)
-	if err := ghClient.TestConnection(); err == nil {
-		fmt.Println("  GitHub CLI auth: OK")
+	// Forge authentication (gh CLI for GitHub, API token for GitLab, ...)
+	if forgeDetected {
+		fg, err := forge.New(cfg, forgeRepo)
+		if err != nil {
+			fmt.Printf("  %s auth: ERROR: %v\n", forge.DisplayName(forgeRepo.Kind), err)
+		} else if err := fg.TestConnection(); err == nil {
+			fmt.Printf("  %s auth: OK\n", forge.DisplayName(forgeRepo.Kind))
+		} else {
+			fmt.Printf("  %s auth: ERROR: %v\n", forge.DisplayName(forgeRepo.Kind), err)
+		}
 	} else {
-		fmt.Printf("  GitHub CLI auth: ERROR: %v\n", err)
+		fmt.Println("  Forge auth: (repository not detected)")
 	}
 
 	// Optional connectivity checks in verbose mode
@@ -1,8 +1,10 @@
 package config
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/adrg/xdg"
 	"gopkg.in/yaml.v3"
@@ -14,24 +16,173 @@ type Config struct {
 	GitHub      GitHubConfig      `yaml:"github"`
 	Git         GitConfig         `yaml:"git"`
 	PullRequest PullRequestConfig `yaml:"pull_request"`
+	Auth        AuthConfig        `yaml:"auth"`
+	Forge       ForgeConfig       `yaml:"forge"`
+	Forges      ForgesConfig      `yaml:"forges"`
+	Tracker     TrackerConfig     `yaml:"tracker"`
+}
+
+// TrackerConfig selects and configures the issue-tracker backend used by
+// start-work, create-pr, and status. Provider defaults to "jira" when
+// empty, so configs written before this key existed keep working
+// unchanged.
+type TrackerConfig struct {
+	Provider string             `yaml:"provider"`
+	GitLab   GitLabIssuesConfig `yaml:"gitlab"`
+	Linear   LinearConfig       `yaml:"linear"`
+}
+
+// GitLabIssuesConfig configures the GitLab-Issues tracker. Host and Token
+// are shared with forges.gitlab, since both talk to the same GitLab
+// instance; ProjectPath is the "owner/repo" the issues belong to.
+type GitLabIssuesConfig struct {
+	ProjectPath string `yaml:"project_path"`
+}
+
+// LinearConfig configures the Linear tracker, talking to Linear's GraphQL
+// API at https://api.linear.app/graphql.
+type LinearConfig struct {
+	APIKey string `yaml:"api_key"`
+}
+
+// Tracker providers supported by TrackerConfig.Provider.
+const (
+	TrackerProviderJira   = "jira"
+	TrackerProviderGitLab = "gitlab"
+	TrackerProviderLinear = "linear"
+	TrackerProviderGitHub = "github"
+)
+
+// ForgesConfig configures forge backends beyond GitHub, which is
+// configured via GitHubConfig for backward compatibility.
+type ForgesConfig struct {
+	GitLab GitLabConfig `yaml:"gitlab"`
+	Gitea  GiteaConfig  `yaml:"gitea"`
+}
+
+// GitLabConfig configures native GitLab merge-request support. Host
+// defaults to "gitlab.com" when empty, so only self-hosted instances need
+// to set it explicitly.
+type GitLabConfig struct {
+	Host  string `yaml:"host"`
+	Token string `yaml:"token"`
+}
+
+// GiteaConfig configures a self-hosted Gitea or Forgejo instance. Forgejo
+// is API-compatible with Gitea, so the same config and backend serve
+// both; ForgeConfig.Type picks which display name/Kind is used.
+type GiteaConfig struct {
+	// APIURL is the instance's API base, e.g.
+	// "https://gitea.example.com/api/v1". Required: unlike GitHub/GitLab,
+	// Gitea/Forgejo have no default public host to fall back to.
+	APIURL string `yaml:"api_url"`
+	Token  string `yaml:"token"`
+}
+
+// ForgeConfig explicitly selects the forge backend, for hosts that
+// forge.DetectFromRemote can't recognize on its own (a self-hosted
+// Gitea/Forgejo instance has no fixed hostname to match against). Empty
+// Type keeps using remote-URL auto-detection against GitHub/GitLab.
+type ForgeConfig struct {
+	// Type is one of "github", "gitlab", "gitea", "forgejo".
+	Type string `yaml:"type"`
+}
+
+// AuthConfig pins which credential (from the internal/auth credential
+// store) a given repo uses, letting one machine talk to multiple JIRA
+// instances and GitHub orgs without re-running the config wizard. Empty
+// values fall back to the default credential for the relevant target.
+type AuthConfig struct {
+	JiraCredentialID   string `yaml:"jira_credential_id"`
+	GitHubCredentialID string `yaml:"github_credential_id"`
+
+	// CredentialSource, if set, forces secrets.GetJiraToken to use exactly
+	// one provider ("keyring", "git-credential", "netrc", or "env")
+	// instead of trying the standard chain in order. Set via the
+	// --credential-source flag; left empty in normal use.
+	CredentialSource string `yaml:"credential_source,omitempty"`
 }
 
 type JiraConfig struct {
 	ServerURL string `yaml:"server_url"`
 	Email     string `yaml:"email"`
+
+	// Username identifies the account for AuthMode "session" (JIRA Server
+	// deployments without personal API tokens, authenticated against
+	// /rest/auth/1/session with a username/password pair instead of Email).
+	Username string `yaml:"username"`
+
+	// AuthMode selects how the client authenticates against ServerURL. One
+	// of "basic" (Atlassian Cloud email + API token, the default), "oauth1"
+	// (three-legged OAuth 1.0a, for self-hosted JIRA Server with an
+	// Application Link), or "session" (JIRA Server username/password
+	// cookie-based login).
+	AuthMode string `yaml:"auth_mode"`
+	// ConsumerKey identifies the Application Link registered in JIRA Server
+	// for oauth1. Required when AuthMode is "oauth1".
+	ConsumerKey string `yaml:"consumer_key"`
+	// PrivateKeyPath points at the PEM-encoded RSA private key used to sign
+	// oauth1 requests (RSA-SHA1). The matching public key must be registered
+	// on the Application Link.
+	PrivateKeyPath string `yaml:"private_key_path"`
+
+	// StartTransition, if set, is the name of the workflow transition
+	// start-work automatically applies to an issue once its branch is ready
+	// (e.g. "In Progress"). Matched case-insensitively against the
+	// transition's target status name. Empty disables the automatic
+	// transition.
+	StartTransition string `yaml:"start_transition"`
+	// ReviewTransition, if set, is the transition create-pr automatically
+	// applies once the pull request is opened (e.g. "In Review").
+	ReviewTransition string `yaml:"review_transition"`
 }
 
+// Jira auth modes supported by JiraConfig.AuthMode.
+const (
+	JiraAuthModeBasic   = "basic"
+	JiraAuthModeOAuth1  = "oauth1"
+	JiraAuthModeSession = "session"
+)
+
 type GitHubConfig struct {
 	Owner string `yaml:"owner"`
 	Repo  string `yaml:"repo"`
+	// Token is a GitHub personal access token (or fine-grained token)
+	// used to talk to the REST API directly. Optional: falls back to
+	// $GITHUB_TOKEN, and then to shelling out to the gh CLI if neither is
+	// set.
+	Token string `yaml:"token"`
 }
 
 type GitConfig struct {
+	// BranchPattern supports the "{issue_id}"/"{issue_title}"/"{issue_type}"
+	// placeholders (and the older "{jira_id}"/"{jira_title}"/"{jira_type}"
+	// spellings, kept as aliases for backward compatibility).
 	BranchPattern   string `yaml:"branch_pattern"`
 	MaxBranchLength int    `yaml:"max_branch_length"`
+	// BranchSeparator joins slugged words in the branch name: "-" or
+	// "_". Defaults to "-" when empty.
+	BranchSeparator string `yaml:"branch_separator"`
+	// BranchCase is "lower" (default) or "preserve".
+	BranchCase string `yaml:"branch_case"`
+	// Backend selects the git.Backend implementation: "exec" (the
+	// default, shelling out to the system git binary) or "gogit" (the
+	// pure-Go github.com/go-git/go-git implementation, useful on hosts
+	// without a git binary installed).
+	Backend string `yaml:"backend"`
 }
 
+// Git backends supported by GitConfig.Backend.
+const (
+	GitBackendExec  = "exec"
+	GitBackendGoGit = "gogit"
+)
+
 type PullRequestConfig struct {
+	// TitlePattern and Template support the same "{issue_*}" placeholders
+	// as GitConfig.BranchPattern, plus "{issue_url}", "{issue_description}"
+	// and "{commit_messages}" (with "{jira_url}"/"{jira_description}" kept
+	// as aliases).
 	TitlePattern string `yaml:"title_pattern"`
 	Draft        bool   `yaml:"draft"`
 	BaseBranch   string `yaml:"base_branch"`
@@ -44,6 +195,10 @@ func DefaultConfig() Config {
 		Jira: JiraConfig{
 			ServerURL: "https://your-company.atlassian.net",
 			Email:     "",
+			AuthMode:  JiraAuthModeBasic,
+		},
+		Tracker: TrackerConfig{
+			Provider: TrackerProviderJira,
 		},
 		GitHub: GitHubConfig{
 			Owner: "",
@@ -85,11 +240,18 @@ func LoadConfigForRepo(owner, repo string) (Config, error) {
 }
 
 // LoadEffectiveConfig loads the configuration using priority order.
-// The last path takes precedence over the previous ones.
+// The last path takes precedence over the previous ones. A path that
+// doesn't exist is skipped rather than treated as an error, so a machine
+// with no global or repo config file yet still gets DefaultConfig()
+// overlaid with environment variables below — the module doesn't need a
+// materialized YAML file to run headless in CI.
 func LoadEffectiveConfig(paths ...string) (Config, error) {
 	cfg := DefaultConfig()
 	for _, path := range paths {
 		data, err := os.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
 		if err != nil {
 			return cfg, err
 		}
@@ -98,9 +260,33 @@ func LoadEffectiveConfig(paths ...string) (Config, error) {
 			return cfg, err
 		}
 	}
+	applyEnvOverlay(&cfg)
 	return cfg, nil
 }
 
+// applyEnvOverlay overlays GIT_AUTOMETA_<SECTION>_<FIELD> environment
+// variables onto cfg, for the fields most commonly needed to run
+// headless (e.g. in CI) without a config file at all. Unset variables
+// leave the existing (default or file-loaded) value untouched.
+func applyEnvOverlay(cfg *Config) {
+	overlayString(&cfg.Jira.ServerURL, "GIT_AUTOMETA_JIRA_SERVER_URL")
+	overlayString(&cfg.Jira.Email, "GIT_AUTOMETA_JIRA_EMAIL")
+	overlayString(&cfg.Jira.AuthMode, "GIT_AUTOMETA_JIRA_AUTH_MODE")
+	overlayString(&cfg.GitHub.Owner, "GIT_AUTOMETA_GITHUB_OWNER")
+	overlayString(&cfg.GitHub.Repo, "GIT_AUTOMETA_GITHUB_REPO")
+	overlayString(&cfg.Forge.Type, "GIT_AUTOMETA_FORGE_TYPE")
+	overlayString(&cfg.PullRequest.BaseBranch, "GIT_AUTOMETA_PULL_REQUEST_BASE_BRANCH")
+	overlayString(&cfg.Auth.CredentialSource, "GIT_AUTOMETA_CREDENTIAL_SOURCE")
+	overlayString(&cfg.Git.Backend, "GIT_AUTOMETA_GIT_BACKEND")
+}
+
+// overlayString sets *field to envVar's value when it's set and non-blank.
+func overlayString(field *string, envVar string) {
+	if v := strings.TrimSpace(os.Getenv(envVar)); v != "" {
+		*field = v
+	}
+}
+
 // GlobalConfigPath returns the global config path using XDG base directories.
 func GlobalConfigPath() string {
 	// Use XDG config directory, defaulting per the library behavior.
@@ -0,0 +1,143 @@
+// Package forge abstracts opening and querying pull/merge requests across
+// code-hosting platforms, so create-pr isn't hard-wired to GitHub.
+package forge
+
+import (
+	"fmt"
+	"strings"
+
+	appconfig "git-autometa/internal/config"
+)
+
+// Repo identifies a repository on a specific forge.
+type Repo struct {
+	Kind  string // "github" or "gitlab"
+	Owner string
+	Name  string
+}
+
+// PullRequest represents minimal data about a pull/merge request.
+type PullRequest struct {
+	Number      int
+	Title       string
+	URL         string
+	HeadRefName string
+	BaseRefName string
+}
+
+// Forge creates and queries pull/merge requests for a single repository.
+// Implementations are bound to one Repo at construction time, mirroring
+// how github.Client is already scoped to an owner/repo pair.
+type Forge interface {
+	// Kind identifies the forge backend, e.g. "github" or "gitlab".
+	Kind() string
+	// TestConnection verifies the forge is reachable with the configured credentials.
+	TestConnection() error
+	// CreatePullRequest opens a pull/merge request and returns its URL.
+	CreatePullRequest(title, body, head, base string, draft bool) (string, error)
+	// ListPullRequests lists pull/merge requests. State is one of: open, closed, merged, all.
+	ListPullRequests(state string, limit int) ([]PullRequest, error)
+	// GetPullRequest fetches a single pull/merge request by number.
+	GetPullRequest(number int) (PullRequest, error)
+	// ClosePullRequest closes a pull/merge request without merging it.
+	ClosePullRequest(number int) error
+}
+
+// detector recognizes a remote URL as belonging to this forge and extracts
+// the repo it points at.
+type detector func(cfg appconfig.Config, remoteURL string) (Repo, bool)
+
+// factory builds a Forge bound to repo.
+type factory func(cfg appconfig.Config, repo Repo) (Forge, error)
+
+type provider struct {
+	detect detector
+	build  factory
+}
+
+var providers = map[string]provider{
+	"github":  {detect: detectGitHub, build: newGitHub},
+	"gitlab":  {detect: detectGitLab, build: newGitLab},
+	"gitea":   {build: newGitea},
+	"forgejo": {build: newGitea},
+}
+
+// DetectFromRemote tries every registered forge with a host-based
+// detector against remoteURL and returns the first match along with its
+// repo and kind. Gitea and Forgejo have no detector: self-hosted
+// instances can live at any hostname, so they require an explicit
+// cfg.Forge.Type (see ResolveRepo) rather than being guessed.
+func DetectFromRemote(cfg appconfig.Config, remoteURL string) (Repo, bool) {
+	// GitHub first: it's by far the common case and its matcher is the
+	// strictest (github.com only), so trying it first avoids ambiguity
+	// with a GitLab self-hosted host list that happens to be empty.
+	for _, kind := range []string{"github", "gitlab"} {
+		if repo, ok := providers[kind].detect(cfg, remoteURL); ok {
+			return repo, true
+		}
+	}
+	return Repo{}, false
+}
+
+// ResolveRepo combines remote-URL auto-detection with an explicit
+// cfg.Forge.Type override for forges DetectFromRemote can't recognize on
+// its own, such as a self-hosted Gitea/Forgejo instance under an
+// arbitrary hostname: when Type is set, owner/repo are parsed generically
+// from the remote's last two path segments instead of matched against a
+// per-forge host list.
+func ResolveRepo(cfg appconfig.Config, remoteURL string) (Repo, bool) {
+	if kind := strings.TrimSpace(cfg.Forge.Type); kind != "" {
+		if owner, name, ok := parseGenericOwnerRepo(remoteURL); ok {
+			return Repo{Kind: kind, Owner: owner, Name: name}, true
+		}
+	}
+	return DetectFromRemote(cfg, remoteURL)
+}
+
+// parseGenericOwnerRepo extracts the trailing "owner/repo" path segments
+// from a remote URL, independent of host, covering both the scp-like SSH
+// form (git@host:owner/repo.git) and standard URL forms.
+func parseGenericOwnerRepo(remote string) (string, string, bool) {
+	s := strings.TrimSuffix(strings.TrimSpace(remote), ".git")
+	if at := strings.Index(s, "@"); at >= 0 && strings.Contains(s, ":") && !strings.Contains(s, "://") {
+		s = strings.Replace(s, ":", "/", 1)
+	}
+	s = strings.TrimPrefix(s, "ssh://")
+	s = strings.TrimPrefix(s, "https://")
+	s = strings.TrimPrefix(s, "http://")
+	parts := strings.Split(s, "/")
+	if len(parts) < 2 {
+		return "", "", false
+	}
+	owner, name := parts[len(parts)-2], parts[len(parts)-1]
+	if owner == "" || name == "" {
+		return "", "", false
+	}
+	return owner, name, true
+}
+
+// New builds the Forge implementation for repo.Kind.
+func New(cfg appconfig.Config, repo Repo) (Forge, error) {
+	p, ok := providers[repo.Kind]
+	if !ok {
+		return nil, fmt.Errorf("forge: unsupported kind %q", repo.Kind)
+	}
+	return p.build(cfg, repo)
+}
+
+// DisplayName returns a human-readable name for a forge kind, for use in
+// status output and log messages. Unknown kinds are returned as-is.
+func DisplayName(kind string) string {
+	switch kind {
+	case "github":
+		return "GitHub"
+	case "gitlab":
+		return "GitLab"
+	case "gitea":
+		return "Gitea"
+	case "forgejo":
+		return "Forgejo"
+	default:
+		return kind
+	}
+}
@@ -0,0 +1,68 @@
+package forge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appconfig "git-autometa/internal/config"
+)
+
+func TestDetectFromRemote_GitHub(t *testing.T) {
+	cfg := appconfig.DefaultConfig()
+	repo, ok := DetectFromRemote(cfg, "git@github.com:acme/widgets.git")
+	require.True(t, ok)
+	assert.Equal(t, Repo{Kind: "github", Owner: "acme", Name: "widgets"}, repo)
+}
+
+func TestDetectFromRemote_GitLab(t *testing.T) {
+	cfg := appconfig.DefaultConfig()
+	repo, ok := DetectFromRemote(cfg, "https://gitlab.com/acme/widgets.git")
+	require.True(t, ok)
+	assert.Equal(t, Repo{Kind: "gitlab", Owner: "acme", Name: "widgets"}, repo)
+}
+
+func TestDetectFromRemote_SelfHostedGitLab(t *testing.T) {
+	cfg := appconfig.DefaultConfig()
+	cfg.Forges.GitLab.Host = "gitlab.example.com"
+	repo, ok := DetectFromRemote(cfg, "git@gitlab.example.com:acme/widgets.git")
+	require.True(t, ok)
+	assert.Equal(t, Repo{Kind: "gitlab", Owner: "acme", Name: "widgets"}, repo)
+}
+
+func TestDetectFromRemote_Unrecognized(t *testing.T) {
+	cfg := appconfig.DefaultConfig()
+	_, ok := DetectFromRemote(cfg, "https://bitbucket.org/acme/widgets.git")
+	assert.False(t, ok)
+}
+
+func TestNew_UnsupportedKind(t *testing.T) {
+	cfg := appconfig.DefaultConfig()
+	_, err := New(cfg, Repo{Kind: "bitbucket", Owner: "acme", Name: "widgets"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "bitbucket")
+}
+
+func TestDisplayName(t *testing.T) {
+	assert.Equal(t, "GitHub", DisplayName("github"))
+	assert.Equal(t, "GitLab", DisplayName("gitlab"))
+	assert.Equal(t, "Gitea", DisplayName("gitea"))
+	assert.Equal(t, "Forgejo", DisplayName("forgejo"))
+	assert.Equal(t, "bitbucket", DisplayName("bitbucket"))
+}
+
+func TestResolveRepo_FallsBackToDetection(t *testing.T) {
+	cfg := appconfig.DefaultConfig()
+	repo, ok := ResolveRepo(cfg, "git@github.com:acme/widgets.git")
+	require.True(t, ok)
+	assert.Equal(t, Repo{Kind: "github", Owner: "acme", Name: "widgets"}, repo)
+}
+
+func TestResolveRepo_ExplicitTypeOverridesHost(t *testing.T) {
+	cfg := appconfig.DefaultConfig()
+	cfg.Forge.Type = "forgejo"
+	repo, ok := ResolveRepo(cfg, "git@git.example.org:acme/widgets.git")
+	require.True(t, ok)
+	assert.Equal(t, Repo{Kind: "forgejo", Owner: "acme", Name: "widgets"}, repo)
+}
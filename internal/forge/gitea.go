@@ -0,0 +1,213 @@
+package forge
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"git-autometa/internal/auth"
+	appconfig "git-autometa/internal/config"
+)
+
+// giteaForge talks to the Gitea/Forgejo REST v1 API directly, which is
+// the same endpoint shape as the go-gitea/sdk client. Forgejo is a
+// Gitea fork that kept API compatibility, so one implementation serves
+// both; kind records which one so Kind()/error messages stay accurate.
+type giteaForge struct {
+	kind       string // "gitea" or "forgejo"
+	baseURL    string // e.g. https://gitea.example.com/api/v1
+	token      string
+	owner      string
+	repo       string
+	httpClient *http.Client
+}
+
+func newGitea(cfg appconfig.Config, repo Repo) (Forge, error) {
+	apiURL := strings.TrimSuffix(strings.TrimSpace(cfg.Forges.Gitea.APIURL), "/")
+	if apiURL == "" {
+		return nil, fmt.Errorf("%s: no API URL configured (set forges.gitea.api_url)", repo.Kind)
+	}
+	token := auth.ResolveToken(cfg.Forges.Gitea.Token, "GITEA_TOKEN", netrcHost(apiURL))
+	if token == "" {
+		return nil, fmt.Errorf("%s: no token configured (set forges.gitea.token, $GITEA_TOKEN, or a ~/.netrc entry for its host)", repo.Kind)
+	}
+	return giteaForge{
+		kind:       repo.Kind,
+		baseURL:    apiURL,
+		token:      token,
+		owner:      repo.Owner,
+		repo:       repo.Name,
+		httpClient: &http.Client{Timeout: 20 * time.Second},
+	}, nil
+}
+
+// netrcHost extracts the host from a Gitea/Forgejo API base URL, for the
+// ~/.netrc machine lookup in auth.ResolveToken. An unparsable URL yields
+// "", which auth.ResolveToken treats as "no netrc lookup".
+func netrcHost(apiURL string) string {
+	u, err := url.Parse(apiURL)
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
+
+func (g giteaForge) Kind() string { return g.kind }
+
+func (g giteaForge) TestConnection() error {
+	_, err := g.do(http.MethodGet, g.repoPath(""), nil)
+	return err
+}
+
+func (g giteaForge) CreatePullRequest(title, body, head, base string, draft bool) (string, error) {
+	if strings.TrimSpace(title) == "" {
+		return "", fmt.Errorf("%s: title is required", g.kind)
+	}
+	payload := map[string]any{
+		"title": title,
+		"body":  body,
+		"head":  head,
+		"base":  base,
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+	resp, err := g.do(http.MethodPost, g.repoPath("/pulls"), data)
+	if err != nil {
+		return "", err
+	}
+	pr, err := decodeGiteaPR(resp)
+	if err != nil {
+		return "", err
+	}
+	if pr.URL == "" {
+		return "", fmt.Errorf("%s: pull request created without an html_url", g.kind)
+	}
+	return pr.URL, nil
+}
+
+func (g giteaForge) ListPullRequests(state string, limit int) ([]PullRequest, error) {
+	path := g.repoPath("/pulls") + "?state=" + giteaState(state)
+	resp, err := g.do(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	var raw []json.RawMessage
+	if err := json.Unmarshal(resp, &raw); err != nil {
+		return nil, fmt.Errorf("%s: unable to parse pull request list: %w", g.kind, err)
+	}
+	out := make([]PullRequest, 0, len(raw))
+	for _, r := range raw {
+		pr, merged, err := decodeGiteaPRWithMerged(r)
+		if err != nil {
+			return nil, err
+		}
+		if state == "merged" && !merged {
+			continue
+		}
+		if limit > 0 && len(out) >= limit {
+			break
+		}
+		out = append(out, pr)
+	}
+	return out, nil
+}
+
+func (g giteaForge) GetPullRequest(number int) (PullRequest, error) {
+	resp, err := g.do(http.MethodGet, g.repoPath(fmt.Sprintf("/pulls/%d", number)), nil)
+	if err != nil {
+		return PullRequest{}, err
+	}
+	return decodeGiteaPR(resp)
+}
+
+func (g giteaForge) ClosePullRequest(number int) error {
+	data, err := json.Marshal(map[string]string{"state": "closed"})
+	if err != nil {
+		return err
+	}
+	_, err = g.do(http.MethodPatch, g.repoPath(fmt.Sprintf("/pulls/%d", number)), data)
+	return err
+}
+
+// giteaState maps the forge-agnostic state names to Gitea/Forgejo's own,
+// which has no separate "merged" filter: merged PRs are "closed" PRs with
+// a non-null merged_at, same as GitHub's REST API.
+func giteaState(state string) string {
+	switch state {
+	case "merged", "closed":
+		return "closed"
+	case "all":
+		return "all"
+	default:
+		return "open"
+	}
+}
+
+func decodeGiteaPR(data []byte) (PullRequest, error) {
+	pr, _, err := decodeGiteaPRWithMerged(data)
+	return pr, err
+}
+
+// decodeGiteaPRWithMerged additionally reports whether the PR is merged,
+// for ListPullRequests' client-side "merged" filter: Gitea/Forgejo has no
+// native "merged" state query, so merged PRs are "closed" PRs with a
+// non-null merged_at, same as decodeGiteaPR's GitHub counterpart.
+func decodeGiteaPRWithMerged(data []byte) (PullRequest, bool, error) {
+	var pr struct {
+		Number   int     `json:"number"`
+		Title    string  `json:"title"`
+		URL      string  `json:"html_url"`
+		MergedAt *string `json:"merged_at"`
+		Head     struct {
+			Ref string `json:"ref"`
+		} `json:"head"`
+		Base struct {
+			Ref string `json:"ref"`
+		} `json:"base"`
+	}
+	if err := json.Unmarshal(data, &pr); err != nil {
+		return PullRequest{}, false, fmt.Errorf("gitea: unable to parse pull request: %w", err)
+	}
+	return PullRequest{
+		Number:      pr.Number,
+		Title:       pr.Title,
+		URL:         pr.URL,
+		HeadRefName: pr.Head.Ref,
+		BaseRefName: pr.Base.Ref,
+	}, pr.MergedAt != nil, nil
+}
+
+func (g giteaForge) repoPath(suffix string) string {
+	return fmt.Sprintf("/repos/%s/%s%s", g.owner, g.repo, suffix)
+}
+
+func (g giteaForge) do(method, path string, body []byte) ([]byte, error) {
+	req, err := http.NewRequest(method, g.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "token "+g.token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := g.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s: request failed: %w", g.kind, err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%s: reading response body: %w", g.kind, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("%s: %s %s returned %d: %s", g.kind, method, path, resp.StatusCode, string(data))
+	}
+	return data, nil
+}
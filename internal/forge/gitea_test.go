@@ -0,0 +1,104 @@
+package forge
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appconfig "git-autometa/internal/config"
+)
+
+func newTestGiteaForge(t *testing.T, handler http.HandlerFunc) (giteaForge, *httptest.Server) {
+	t.Helper()
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+	return giteaForge{
+		kind:       "gitea",
+		baseURL:    ts.URL,
+		token:      "tok",
+		owner:      "acme",
+		repo:       "widgets",
+		httpClient: ts.Client(),
+	}, ts
+}
+
+func TestGiteaForge_CreatePullRequest(t *testing.T) {
+	g, _ := newTestGiteaForge(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/repos/acme/widgets/pulls", r.URL.Path)
+		require.Equal(t, "token tok", r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"number":7,"title":"Add feature","html_url":"https://gitea.example.com/acme/widgets/pulls/7","head":{"ref":"feature/x"},"base":{"ref":"main"}}`))
+	})
+
+	url, err := g.CreatePullRequest("Add feature", "body", "feature/x", "main", false)
+	require.NoError(t, err)
+	assert.Equal(t, "https://gitea.example.com/acme/widgets/pulls/7", url)
+}
+
+func TestGiteaForge_CreatePullRequest_RequiresTitle(t *testing.T) {
+	g, _ := newTestGiteaForge(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Fail(t, "server should not be called when title is empty")
+	})
+	_, err := g.CreatePullRequest("", "body", "feature/x", "main", false)
+	require.Error(t, err)
+}
+
+func TestGiteaForge_ListPullRequests(t *testing.T) {
+	g, _ := newTestGiteaForge(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "state=open", r.URL.RawQuery)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[{"number":1,"title":"Fix bug","html_url":"u","head":{"ref":"f"},"base":{"ref":"main"}}]`))
+	})
+
+	prs, err := g.ListPullRequests("open", 0)
+	require.NoError(t, err)
+	require.Len(t, prs, 1)
+	assert.Equal(t, PullRequest{Number: 1, Title: "Fix bug", URL: "u", HeadRefName: "f", BaseRefName: "main"}, prs[0])
+}
+
+func TestGiteaForge_ListPullRequests_MergedFiltersLocally(t *testing.T) {
+	g, _ := newTestGiteaForge(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "state=closed", r.URL.RawQuery)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[
+			{"number":1,"title":"Merged PR","html_url":"https://x/pr/1","merged_at":"2024-01-01T00:00:00Z","head":{"ref":"feat/a"},"base":{"ref":"main"}},
+			{"number":2,"title":"Closed, not merged","html_url":"https://x/pr/2","merged_at":null,"head":{"ref":"feat/b"},"base":{"ref":"main"}}
+		]`))
+	})
+
+	prs, err := g.ListPullRequests("merged", 10)
+	require.NoError(t, err)
+	require.Len(t, prs, 1)
+	assert.Equal(t, 1, prs[0].Number)
+}
+
+func TestGiteaForge_ClosePullRequest(t *testing.T) {
+	g, _ := newTestGiteaForge(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPatch, r.Method)
+		require.Equal(t, "/repos/acme/widgets/pulls/7", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	})
+
+	require.NoError(t, g.ClosePullRequest(7))
+}
+
+func TestNewGitea_RequiresAPIURL(t *testing.T) {
+	cfg := appconfig.DefaultConfig()
+	cfg.Forges.Gitea.Token = "tok"
+	_, err := newGitea(cfg, Repo{Kind: "gitea", Owner: "acme", Name: "widgets"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "API URL")
+}
+
+func TestNewGitea_RequiresToken(t *testing.T) {
+	cfg := appconfig.DefaultConfig()
+	cfg.Forges.Gitea.APIURL = "https://gitea.example.com/api/v1"
+	_, err := newGitea(cfg, Repo{Kind: "forgejo", Owner: "acme", Name: "widgets"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "token")
+}
@@ -0,0 +1,99 @@
+package forge
+
+import (
+	"strings"
+
+	appconfig "git-autometa/internal/config"
+	"git-autometa/internal/github"
+)
+
+type githubForge struct {
+	client github.Client
+}
+
+func newGitHub(cfg appconfig.Config, repo Repo) (Forge, error) {
+	scoped := cfg
+	scoped.GitHub.Owner = repo.Owner
+	scoped.GitHub.Repo = repo.Name
+	return githubForge{client: github.NewClient(scoped)}, nil
+}
+
+func (g githubForge) Kind() string { return "github" }
+
+func (g githubForge) TestConnection() error { return g.client.TestConnection() }
+
+func (g githubForge) CreatePullRequest(title, body, head, base string, draft bool) (string, error) {
+	return g.client.CreatePullRequest(title, body, head, base, draft)
+}
+
+func (g githubForge) ListPullRequests(state string, limit int) ([]PullRequest, error) {
+	prs, err := g.client.ListPullRequests(state, limit)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]PullRequest, len(prs))
+	for i, pr := range prs {
+		out[i] = PullRequest{
+			Number:      pr.Number,
+			Title:       pr.Title,
+			URL:         pr.URL,
+			HeadRefName: pr.HeadRefName,
+			BaseRefName: pr.BaseRefName,
+		}
+	}
+	return out, nil
+}
+
+func (g githubForge) GetPullRequest(number int) (PullRequest, error) {
+	pr, err := g.client.GetPullRequest(number)
+	if err != nil {
+		return PullRequest{}, err
+	}
+	return PullRequest{
+		Number:      pr.Number,
+		Title:       pr.Title,
+		URL:         pr.URL,
+		HeadRefName: pr.HeadRefName,
+		BaseRefName: pr.BaseRefName,
+	}, nil
+}
+
+func (g githubForge) ClosePullRequest(number int) error {
+	return g.client.ClosePullRequest(number)
+}
+
+// detectGitHub recognizes github.com remotes, matching the SSH and HTTPS
+// forms git-autometa has always accepted.
+func detectGitHub(_ appconfig.Config, remote string) (Repo, bool) {
+	owner, name, ok := ParseGitHubOwnerRepo(remote)
+	if !ok {
+		return Repo{}, false
+	}
+	return Repo{Kind: "github", Owner: owner, Name: name}, true
+}
+
+// ParseGitHubOwnerRepo extracts the owner/repo pair from a github.com remote
+// URL, in either scp-like SSH form (git@github.com:owner/repo.git) or a
+// standard URL form (https://github.com/owner/repo.git).
+func ParseGitHubOwnerRepo(remote string) (string, string, bool) {
+	s := strings.TrimSuffix(remote, ".git")
+	if strings.HasPrefix(s, "git@github.com:") {
+		s = strings.Replace(s, ":", "/", 1)
+		s = "ssh://" + s
+	}
+	hostIdx := strings.Index(s, "github.com")
+	if hostIdx < 0 {
+		return "", "", false
+	}
+	after := s[hostIdx+len("github.com"):]
+	after = strings.TrimPrefix(after, "/")
+	parts := strings.Split(after, "/")
+	if len(parts) < 2 {
+		return "", "", false
+	}
+	owner, repo := parts[0], parts[1]
+	if owner == "" || repo == "" {
+		return "", "", false
+	}
+	return owner, repo, true
+}
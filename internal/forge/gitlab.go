@@ -0,0 +1,211 @@
+package forge
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"git-autometa/internal/auth"
+	appconfig "git-autometa/internal/config"
+)
+
+// gitlabForge talks to the GitLab REST v4 API directly (no gh-equivalent
+// CLI dependency is assumed to be installed for GitLab).
+type gitlabForge struct {
+	baseURL     string // e.g. https://gitlab.com
+	token       string
+	projectPath string // URL-encoded "owner/repo"
+	httpClient  *http.Client
+}
+
+func newGitLab(cfg appconfig.Config, repo Repo) (Forge, error) {
+	host := strings.TrimSpace(cfg.Forges.GitLab.Host)
+	if host == "" {
+		host = "gitlab.com"
+	}
+	token := auth.ResolveToken(cfg.Forges.GitLab.Token, "GITLAB_TOKEN", host)
+	if token == "" {
+		return nil, fmt.Errorf("gitlab: no token configured (set forges.gitlab.token, $GITLAB_TOKEN, or a ~/.netrc entry for %s)", host)
+	}
+	return gitlabForge{
+		baseURL:     "https://" + host,
+		token:       token,
+		projectPath: url.PathEscape(repo.Owner + "/" + repo.Name),
+		httpClient:  &http.Client{Timeout: 20 * time.Second},
+	}, nil
+}
+
+func (g gitlabForge) Kind() string { return "gitlab" }
+
+func (g gitlabForge) TestConnection() error {
+	_, err := g.do(http.MethodGet, "/projects/"+g.projectPath, nil)
+	return err
+}
+
+func (g gitlabForge) CreatePullRequest(title, body, head, base string, draft bool) (string, error) {
+	if strings.TrimSpace(title) == "" {
+		return "", fmt.Errorf("gitlab: title is required")
+	}
+	if draft {
+		title = "Draft: " + title
+	}
+	payload := map[string]string{
+		"source_branch": head,
+		"target_branch": base,
+		"title":         title,
+		"description":   body,
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+	resp, err := g.do(http.MethodPost, "/projects/"+g.projectPath+"/merge_requests", data)
+	if err != nil {
+		return "", err
+	}
+	var mr struct {
+		WebURL string `json:"web_url"`
+	}
+	if err := json.Unmarshal(resp, &mr); err != nil {
+		return "", fmt.Errorf("gitlab: unable to parse merge request response: %w", err)
+	}
+	if mr.WebURL == "" {
+		return "", fmt.Errorf("gitlab: merge request created without a web_url")
+	}
+	return mr.WebURL, nil
+}
+
+func (g gitlabForge) ListPullRequests(state string, limit int) ([]PullRequest, error) {
+	path := "/projects/" + g.projectPath + "/merge_requests"
+	q := url.Values{}
+	if state != "" && state != "all" {
+		q.Set("state", gitlabState(state))
+	}
+	if limit > 0 {
+		q.Set("per_page", fmt.Sprintf("%d", limit))
+	}
+	if enc := q.Encode(); enc != "" {
+		path += "?" + enc
+	}
+	resp, err := g.do(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	var mrs []struct {
+		IID          int    `json:"iid"`
+		Title        string `json:"title"`
+		WebURL       string `json:"web_url"`
+		SourceBranch string `json:"source_branch"`
+		TargetBranch string `json:"target_branch"`
+	}
+	if err := json.Unmarshal(resp, &mrs); err != nil {
+		return nil, fmt.Errorf("gitlab: unable to parse merge request list: %w", err)
+	}
+	out := make([]PullRequest, len(mrs))
+	for i, mr := range mrs {
+		out[i] = PullRequest{
+			Number:      mr.IID,
+			Title:       mr.Title,
+			URL:         mr.WebURL,
+			HeadRefName: mr.SourceBranch,
+			BaseRefName: mr.TargetBranch,
+		}
+	}
+	return out, nil
+}
+
+func (g gitlabForge) GetPullRequest(number int) (PullRequest, error) {
+	resp, err := g.do(http.MethodGet, fmt.Sprintf("/projects/%s/merge_requests/%d", g.projectPath, number), nil)
+	if err != nil {
+		return PullRequest{}, err
+	}
+	var mr struct {
+		IID          int    `json:"iid"`
+		Title        string `json:"title"`
+		WebURL       string `json:"web_url"`
+		SourceBranch string `json:"source_branch"`
+		TargetBranch string `json:"target_branch"`
+	}
+	if err := json.Unmarshal(resp, &mr); err != nil {
+		return PullRequest{}, fmt.Errorf("gitlab: unable to parse merge request response: %w", err)
+	}
+	return PullRequest{
+		Number:      mr.IID,
+		Title:       mr.Title,
+		URL:         mr.WebURL,
+		HeadRefName: mr.SourceBranch,
+		BaseRefName: mr.TargetBranch,
+	}, nil
+}
+
+func (g gitlabForge) ClosePullRequest(number int) error {
+	path := fmt.Sprintf("/projects/%s/merge_requests/%d?state_event=close", g.projectPath, number)
+	_, err := g.do(http.MethodPut, path, nil)
+	return err
+}
+
+// gitlabState maps the forge-agnostic state names to GitLab's own.
+func gitlabState(state string) string {
+	if state == "merged" {
+		return "merged"
+	}
+	if state == "closed" {
+		return "closed"
+	}
+	return "opened"
+}
+
+func (g gitlabForge) do(method, path string, body []byte) ([]byte, error) {
+	req, err := http.NewRequest(method, g.baseURL+"/api/v4"+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("PRIVATE-TOKEN", g.token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := g.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("gitlab: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("gitlab: reading response body: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("gitlab: %s %s returned %d: %s", method, path, resp.StatusCode, string(data))
+	}
+	return data, nil
+}
+
+// detectGitLab recognizes gitlab.com and any self-hosted GitLab hosts listed
+// under forges.gitlab.host in config.
+func detectGitLab(cfg appconfig.Config, remote string) (Repo, bool) {
+	hosts := []string{"gitlab.com"}
+	if h := strings.TrimSpace(cfg.Forges.GitLab.Host); h != "" && h != "gitlab.com" {
+		hosts = append(hosts, h)
+	}
+	s := strings.TrimSuffix(remote, ".git")
+	for _, host := range hosts {
+		if strings.HasPrefix(s, "git@"+host+":") {
+			s = "ssh://" + strings.Replace(s, ":", "/", 1)
+		}
+		hostIdx := strings.Index(s, host)
+		if hostIdx < 0 {
+			continue
+		}
+		after := strings.TrimPrefix(s[hostIdx+len(host):], "/")
+		parts := strings.Split(after, "/")
+		if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+			continue
+		}
+		return Repo{Kind: "gitlab", Owner: parts[0], Name: parts[1]}, true
+	}
+	return Repo{}, false
+}
@@ -0,0 +1,110 @@
+package forge
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appconfig "git-autometa/internal/config"
+)
+
+func newTestGitLabForge(t *testing.T, handler http.HandlerFunc) (gitlabForge, *httptest.Server) {
+	t.Helper()
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+	return gitlabForge{
+		baseURL:     ts.URL,
+		token:       "tok",
+		projectPath: "acme%2Fwidgets",
+		httpClient:  ts.Client(),
+	}, ts
+}
+
+func TestGitLabForge_CreatePullRequest(t *testing.T) {
+	g, _ := newTestGitLabForge(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/api/v4/projects/acme%2Fwidgets/merge_requests", r.URL.EscapedPath())
+		require.Equal(t, "tok", r.Header.Get("PRIVATE-TOKEN"))
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"web_url":"https://gitlab.com/acme/widgets/-/merge_requests/7"}`))
+	})
+
+	url, err := g.CreatePullRequest("Add feature", "body", "feature/x", "main", false)
+	require.NoError(t, err)
+	assert.Equal(t, "https://gitlab.com/acme/widgets/-/merge_requests/7", url)
+}
+
+func TestGitLabForge_CreatePullRequest_DraftPrefixesTitle(t *testing.T) {
+	var gotTitle string
+	g, _ := newTestGitLabForge(t, func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			Title string `json:"title"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		gotTitle = payload.Title
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"web_url":"https://gitlab.com/acme/widgets/-/merge_requests/8"}`))
+	})
+
+	_, err := g.CreatePullRequest("Add feature", "body", "feature/x", "main", true)
+	require.NoError(t, err)
+	assert.Equal(t, "Draft: Add feature", gotTitle)
+}
+
+func TestGitLabForge_CreatePullRequest_ErrorStatus(t *testing.T) {
+	g, _ := newTestGitLabForge(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"message":"forbidden"}`))
+	})
+
+	_, err := g.CreatePullRequest("Add feature", "body", "feature/x", "main", false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "403")
+}
+
+func TestGitLabForge_ListPullRequests(t *testing.T) {
+	g, _ := newTestGitLabForge(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "state=opened", r.URL.RawQuery)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[{"iid":3,"title":"Fix bug","web_url":"u","source_branch":"f","target_branch":"main"}]`))
+	})
+
+	prs, err := g.ListPullRequests("open", 0)
+	require.NoError(t, err)
+	require.Len(t, prs, 1)
+	assert.Equal(t, PullRequest{Number: 3, Title: "Fix bug", URL: "u", HeadRefName: "f", BaseRefName: "main"}, prs[0])
+}
+
+func TestGitLabForge_GetPullRequest(t *testing.T) {
+	g, _ := newTestGitLabForge(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v4/projects/acme%2Fwidgets/merge_requests/3", r.URL.EscapedPath())
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"iid":3,"title":"Fix bug","web_url":"u","source_branch":"f","target_branch":"main"}`))
+	})
+
+	pr, err := g.GetPullRequest(3)
+	require.NoError(t, err)
+	assert.Equal(t, PullRequest{Number: 3, Title: "Fix bug", URL: "u", HeadRefName: "f", BaseRefName: "main"}, pr)
+}
+
+func TestGitLabForge_ClosePullRequest(t *testing.T) {
+	g, _ := newTestGitLabForge(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPut, r.Method)
+		require.Equal(t, "state_event=close", r.URL.RawQuery)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	})
+
+	require.NoError(t, g.ClosePullRequest(3))
+}
+
+func TestNewGitLab_RequiresToken(t *testing.T) {
+	cfg := appconfig.DefaultConfig()
+	_, err := newGitLab(cfg, Repo{Kind: "gitlab", Owner: "acme", Name: "widgets"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "token")
+}
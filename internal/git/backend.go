@@ -0,0 +1,61 @@
+package git
+
+import (
+	appconfig "git-autometa/internal/config"
+	"git-autometa/internal/gitutils"
+)
+
+// Backend is the high-level git surface start-work, create-pr, and status
+// need: preparing and pushing a work branch, and reading the current
+// branch/commits/remote for PR creation. *Git (exec, the default) and
+// *gitutils.Utils (go-git, selected via git.backend: gogit) both
+// implement it.
+type Backend interface {
+	PrepareWorkBranch(desiredBranchName string) (string, error)
+	PushBranch(branchName string) error
+	GetCurrentBranch() (string, error)
+	GetCommitMessagesForPR(baseBranch string) ([]string, error)
+	GetRemoteURL(remote string) (string, error)
+}
+
+// NewFromConfig returns the Backend selected by cfg.Git.Backend ("exec",
+// the default, or "gogit"), operating in the current process working
+// directory.
+func NewFromConfig(cfg appconfig.Config) Backend {
+	return NewFromConfigWithWorkDir(cfg, "")
+}
+
+// NewFromConfigWithWorkDir is NewFromConfig bound to a specific working
+// directory.
+func NewFromConfigWithWorkDir(cfg appconfig.Config, dir string) Backend {
+	return NewFromConfigWithPushOptions(cfg, dir, PushOptions{})
+}
+
+// NewFromConfigWithPushOptions is NewFromConfig with SSH push options
+// (e.g. create-pr's --ssh-key flag) layered onto the selected backend.
+func NewFromConfigWithPushOptions(cfg appconfig.Config, dir string, opts PushOptions) Backend {
+	return NewFromConfigWithOptions(cfg, dir, "", opts)
+}
+
+// NewFromConfigWithOptions is NewFromConfigWithPushOptions with an
+// explicit override for the repository's default/main branch (e.g.
+// cfg.PullRequest.BaseBranch for start-work), set on whichever backend is
+// selected instead of callers reaching into *Git/*gitutils.Utils
+// directly and accidentally pinning the exec backend.
+func NewFromConfigWithOptions(cfg appconfig.Config, dir, mainBranch string, opts PushOptions) Backend {
+	if cfg.Git.Backend == appconfig.GitBackendGoGit {
+		u := gitutils.NewWithWorkDir(dir)
+		u.MainBranch = mainBranch
+		u.SSH = gitutils.PushOptions{
+			SSHKeyPath:       opts.SSHKeyPath,
+			SSHKeyPassphrase: opts.SSHKeyPassphrase,
+			KnownHostsPath:   opts.KnownHostsPath,
+			UseAgent:         opts.UseAgent,
+		}
+		return u
+	}
+	g := NewWithWorkDir(dir)
+	g.MainBranch = mainBranch
+	g.SSH = opts
+	return g
+}
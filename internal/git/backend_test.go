@@ -0,0 +1,46 @@
+package git
+
+import (
+	"testing"
+
+	appconfig "git-autometa/internal/config"
+	"git-autometa/internal/gitutils"
+)
+
+func TestNewFromConfig_DefaultsToExec(t *testing.T) {
+	backend := NewFromConfig(appconfig.Config{})
+	if _, ok := backend.(*Git); !ok {
+		t.Fatalf("expected the exec backend (*Git) by default, got %T", backend)
+	}
+}
+
+func TestNewFromConfig_GoGit(t *testing.T) {
+	cfg := appconfig.Config{Git: appconfig.GitConfig{Backend: appconfig.GitBackendGoGit}}
+	backend := NewFromConfig(cfg)
+	if _, ok := backend.(*gitutils.Utils); !ok {
+		t.Fatalf("expected the go-git backend (*gitutils.Utils), got %T", backend)
+	}
+}
+
+func TestNewFromConfigWithOptions_SetsMainBranchOnExec(t *testing.T) {
+	backend := NewFromConfigWithOptions(appconfig.Config{}, "", "trunk", PushOptions{})
+	g, ok := backend.(*Git)
+	if !ok {
+		t.Fatalf("expected the exec backend (*Git), got %T", backend)
+	}
+	if g.MainBranch != "trunk" {
+		t.Fatalf("expected MainBranch %q, got %q", "trunk", g.MainBranch)
+	}
+}
+
+func TestNewFromConfigWithOptions_SetsMainBranchOnGoGit(t *testing.T) {
+	cfg := appconfig.Config{Git: appconfig.GitConfig{Backend: appconfig.GitBackendGoGit}}
+	backend := NewFromConfigWithOptions(cfg, "", "trunk", PushOptions{})
+	u, ok := backend.(*gitutils.Utils)
+	if !ok {
+		t.Fatalf("expected the go-git backend (*gitutils.Utils), got %T", backend)
+	}
+	if u.MainBranch != "trunk" {
+		t.Fatalf("expected MainBranch %q, got %q", "trunk", u.MainBranch)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"os"
 	"os/exec"
 	"regexp"
 	"strconv"
@@ -15,6 +16,64 @@ type Git struct {
 	// WorkDir is the filesystem path where git commands should run.
 	// If empty, commands run in the current process working directory.
 	WorkDir string
+	// MainBranch, if set, is returned by DefaultBranch without probing
+	// the repository at all. Leave empty to auto-detect.
+	MainBranch string
+	// SSH configures PushBranch's SSH authentication beyond whatever the
+	// ambient ssh-agent/~/.ssh/config already provides. A zero value
+	// pushes exactly as before.
+	SSH PushOptions
+}
+
+// PushOptions pins PushBranch's SSH authentication: a specific private
+// key, a known_hosts file, or forcing use of the running ssh-agent.
+// Construct via the --ssh-key flag (internal/cli) or directly for tests.
+type PushOptions struct {
+	// SSHKeyPath, if set, is passed to ssh as "-i <path> -o
+	// IdentitiesOnly=yes" instead of relying on the ambient agent/config
+	// to pick an identity.
+	SSHKeyPath string
+	// SSHKeyPassphrase decrypts SSHKeyPath under the go-git backend
+	// (gitutils.PushOptions); the exec backend has no way to feed ssh a
+	// passphrase directly and relies on ssh-agent/SSH_ASKPASS instead.
+	// Looked up from the secrets keyring under "ssh:<path>" by
+	// secrets.GetSSHKeyPassphrase.
+	SSHKeyPassphrase string
+	// KnownHostsPath, if set, is passed to ssh as "-o
+	// UserKnownHostsFile=<path>".
+	KnownHostsPath string
+	// UseAgent is a no-op for the exec backend, which already defers to
+	// the ambient ssh-agent; kept here so callers can set PushOptions
+	// once and hand it to either backend.
+	UseAgent bool
+}
+
+// sshCommand builds the "ssh ..." command line for GIT_SSH_COMMAND, or ""
+// if there's nothing to pin (pure ambient behavior).
+//
+// git runs GIT_SSH_COMMAND through "sh -c", so every argument must be
+// POSIX shell quoted (shellQuote), not just Go-quoted with %q: a path
+// containing "$(...)" or backticks would otherwise be executed by the
+// shell instead of passed through to ssh literally.
+func (o PushOptions) sshCommand() string {
+	if o.SSHKeyPath == "" && o.KnownHostsPath == "" {
+		return ""
+	}
+	parts := []string{"ssh"}
+	if o.SSHKeyPath != "" {
+		parts = append(parts, "-i", shellQuote(o.SSHKeyPath), "-o", "IdentitiesOnly=yes")
+	}
+	if o.KnownHostsPath != "" {
+		parts = append(parts, "-o", shellQuote("UserKnownHostsFile="+o.KnownHostsPath))
+	}
+	return strings.Join(parts, " ")
+}
+
+// shellQuote wraps s in single quotes for safe use in a POSIX shell
+// command line. Embedded single quotes are escaped by closing the quoted
+// string, emitting a backslash-escaped quote, and reopening it.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
 }
 
 func New() *Git { return &Git{} }
@@ -34,13 +93,12 @@ func (g *Git) PrepareWorkBranch(desiredBranchName string) (string, error) {
 	// Fetch remotes if any
 	_, _ = runGitDir(g.WorkDir, "fetch", "--all", "-p")
 
-	// Detect main branch: prefer main, fallback to master
-	mainBranch := "main"
-	hasMain := branchExistsLocallyDir(g.WorkDir, "main") || remoteBranchExistsDir(g.WorkDir, "origin", "main")
-	if !hasMain {
-		if branchExistsLocallyDir(g.WorkDir, "master") || remoteBranchExistsDir(g.WorkDir, "origin", "master") {
-			mainBranch = "master"
-		}
+	// Detect the default/main branch to base the new branch on. Fall back
+	// to the bare "main" guess when detection fails (e.g. a fresh repo
+	// with no commits or remote yet) rather than erroring out.
+	mainBranch, err := g.DefaultBranch()
+	if err != nil {
+		mainBranch = "main"
 	}
 
 	// Checkout main branch if it exists locally, otherwise create it tracking remote if present.
@@ -85,7 +143,11 @@ func (g *Git) PushBranch(branchName string) error {
 	if !hasRemoteDir(g.WorkDir, "origin") {
 		return errors.New("no 'origin' remote configured")
 	}
-	_, err := runGitDir(g.WorkDir, "push", "-u", "origin", branchName)
+	var extraEnv []string
+	if cmd := g.SSH.sshCommand(); cmd != "" {
+		extraEnv = append(extraEnv, "GIT_SSH_COMMAND="+cmd)
+	}
+	_, err := runGitDirWithEnv(g.WorkDir, extraEnv, "push", "-u", "origin", branchName)
 	return err
 }
 
@@ -143,6 +205,64 @@ func (g *Git) GetRemoteURL(remote string) (string, error) {
 	return out, nil
 }
 
+// DefaultBranch resolves the repository's default branch, in order of
+// preference: g.MainBranch if set explicitly; refs/remotes/origin/HEAD
+// (repaired with "git remote set-head origin --auto" if missing); the
+// "HEAD branch:" line from "git remote show origin"; and finally a
+// main/master probe, for repos with no origin at all.
+func (g *Git) DefaultBranch() (string, error) {
+	if g.MainBranch != "" {
+		return g.MainBranch, nil
+	}
+	if err := g.assertGitRepo(); err != nil {
+		return "", err
+	}
+	if branch, ok := g.originHEADBranch(); ok {
+		return branch, nil
+	}
+	if hasRemoteDir(g.WorkDir, "origin") {
+		_, _ = runGitDir(g.WorkDir, "remote", "set-head", "origin", "--auto")
+		if branch, ok := g.originHEADBranch(); ok {
+			return branch, nil
+		}
+		if branch, ok := g.remoteShowHEADBranch(); ok {
+			return branch, nil
+		}
+	}
+	if branchExistsLocallyDir(g.WorkDir, "main") || remoteBranchExistsDir(g.WorkDir, "origin", "main") {
+		return "main", nil
+	}
+	if branchExistsLocallyDir(g.WorkDir, "master") || remoteBranchExistsDir(g.WorkDir, "origin", "master") {
+		return "master", nil
+	}
+	return "", errors.New("unable to determine the repository's default branch")
+}
+
+// originHEADBranch reads refs/remotes/origin/HEAD, which a plain "git
+// clone" sets up automatically but "git init" + "git remote add" does not.
+func (g *Git) originHEADBranch() (string, bool) {
+	out, err := runGitDir(g.WorkDir, "symbolic-ref", "--short", "refs/remotes/origin/HEAD")
+	if err != nil {
+		return "", false
+	}
+	return strings.TrimPrefix(out, "origin/"), true
+}
+
+// remoteShowHEADBranch asks the remote directly for its HEAD branch,
+// parsing the "HEAD branch: <name>" line from "git remote show origin".
+func (g *Git) remoteShowHEADBranch() (string, bool) {
+	out, err := runGitDir(g.WorkDir, "remote", "show", "origin")
+	if err != nil {
+		return "", false
+	}
+	for _, line := range strings.Split(out, "\n") {
+		if branch, ok := strings.CutPrefix(strings.TrimSpace(line), "HEAD branch:"); ok {
+			return strings.TrimSpace(branch), true
+		}
+	}
+	return "", false
+}
+
 // --- helpers ---
 
 func (g *Git) assertGitRepo() error {
@@ -151,10 +271,19 @@ func (g *Git) assertGitRepo() error {
 }
 
 func runGitDir(dir string, args ...string) (string, error) {
+	return runGitDirWithEnv(dir, nil, args...)
+}
+
+// runGitDirWithEnv is runGitDir with extra "KEY=value" entries appended to
+// the command's environment, for GIT_SSH_COMMAND.
+func runGitDirWithEnv(dir string, extraEnv []string, args ...string) (string, error) {
 	cmd := exec.Command("git", args...)
 	if dir != "" {
 		cmd.Dir = dir
 	}
+	if len(extraEnv) > 0 {
+		cmd.Env = append(os.Environ(), extraEnv...)
+	}
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
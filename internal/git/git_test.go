@@ -103,6 +103,62 @@ func TestGetCurrentBranch(t *testing.T) {
 	assert.Equal(t, "feat/current", cur)
 }
 
+func TestDefaultBranch_ExplicitMainBranchWins(t *testing.T) {
+	repoDir := initTempRepo(t)
+	git := Git{WorkDir: repoDir, MainBranch: "trunk"}
+
+	branch, err := git.DefaultBranch()
+	require.NoError(t, err)
+	assert.Equal(t, "trunk", branch)
+}
+
+func TestDefaultBranch_FromOriginHEAD(t *testing.T) {
+	repoDir := initTempRepo(t)
+	runCmd(t, repoDir, "git", "checkout", "-b", "trunk")
+	runCmd(t, repoDir, "git", "branch", "-D", "main")
+
+	remoteDir := t.TempDir()
+	runCmd(t, remoteDir, "git", "init", "--bare")
+	runCmd(t, repoDir, "git", "remote", "add", "origin", remoteDir)
+	runCmd(t, repoDir, "git", "push", "-u", "origin", "trunk")
+	runCmd(t, repoDir, "git", "remote", "set-head", "origin", "trunk")
+
+	git := Git{WorkDir: repoDir}
+	branch, err := git.DefaultBranch()
+	require.NoError(t, err)
+	assert.Equal(t, "trunk", branch)
+}
+
+func TestDefaultBranch_RepairsMissingOriginHEAD(t *testing.T) {
+	repoDir := initTempRepo(t)
+	runCmd(t, repoDir, "git", "checkout", "-b", "trunk")
+	runCmd(t, repoDir, "git", "branch", "-D", "main")
+
+	remoteDir := t.TempDir()
+	runCmd(t, remoteDir, "git", "init", "--bare")
+	runCmd(t, remoteDir, "git", "symbolic-ref", "HEAD", "refs/heads/trunk")
+	runCmd(t, repoDir, "git", "remote", "add", "origin", remoteDir)
+	runCmd(t, repoDir, "git", "push", "-u", "origin", "trunk")
+	// Simulate a repo where refs/remotes/origin/HEAD was never set, as
+	// happens with "git init" + "git remote add" instead of "git clone".
+	_, symErr := exec.Command("git", "-C", repoDir, "symbolic-ref", "-d", "refs/remotes/origin/HEAD").CombinedOutput()
+	_ = symErr
+
+	git := Git{WorkDir: repoDir}
+	branch, err := git.DefaultBranch()
+	require.NoError(t, err)
+	assert.Equal(t, "trunk", branch)
+}
+
+func TestDefaultBranch_MainMasterProbeWithNoOrigin(t *testing.T) {
+	repoDir := initTempRepo(t)
+	git := Git{WorkDir: repoDir}
+
+	branch, err := git.DefaultBranch()
+	require.NoError(t, err)
+	assert.Equal(t, "main", branch)
+}
+
 func TestGetCommitMessagesForPR_CleansTags(t *testing.T) {
 	repoDir := initTempRepo(t)
 	git := Git{WorkDir: repoDir, MainBranch: "main"}
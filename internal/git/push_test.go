@@ -0,0 +1,46 @@
+package git
+
+import "testing"
+
+func TestPushOptions_SSHCommand_Empty(t *testing.T) {
+	if got := (PushOptions{}).sshCommand(); got != "" {
+		t.Fatalf("expected no GIT_SSH_COMMAND for a zero value, got %q", got)
+	}
+}
+
+func TestPushOptions_SSHCommand_KeyPath(t *testing.T) {
+	got := PushOptions{SSHKeyPath: "/home/me/.ssh/id_deploy"}.sshCommand()
+	want := `ssh -i '/home/me/.ssh/id_deploy' -o IdentitiesOnly=yes`
+	if got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestPushOptions_SSHCommand_KnownHosts(t *testing.T) {
+	got := PushOptions{SSHKeyPath: "/k", KnownHostsPath: "/known_hosts"}.sshCommand()
+	want := `ssh -i '/k' -o IdentitiesOnly=yes -o 'UserKnownHostsFile=/known_hosts'`
+	if got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+// TestPushOptions_SSHCommand_RejectsShellInjection guards against a
+// regression to the old fmt.Sprintf("%q", ...) quoting: git runs
+// GIT_SSH_COMMAND through "sh -c", so Go-style quoting doesn't stop a
+// path containing "$(...)" or backticks from being executed by the
+// shell. The single-quoted output must neutralize that.
+func TestPushOptions_SSHCommand_RejectsShellInjection(t *testing.T) {
+	got := PushOptions{SSHKeyPath: `$(touch PWNED)`}.sshCommand()
+	want := `ssh -i '$(touch PWNED)' -o IdentitiesOnly=yes`
+	if got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestPushOptions_SSHCommand_EscapesEmbeddedSingleQuote(t *testing.T) {
+	got := PushOptions{SSHKeyPath: `/tmp/it's-mine`}.sshCommand()
+	want := `ssh -i '/tmp/it'\''s-mine' -o IdentitiesOnly=yes`
+	if got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
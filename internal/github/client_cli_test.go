@@ -8,18 +8,11 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-
-	appconfig "git-autometa/internal/config"
 )
 
 func newTestClientWithRunner(t *testing.T, run runnerFunc) Client {
 	t.Helper()
-	cfg := appconfig.Config{
-		GitHub: appconfig.GitHubConfig{Owner: "acme", Repo: "project"},
-	}
-	c := NewClient(cfg)
-	c.run = run
-	return c
+	return Client{transport: cliTransport{owner: "acme", repo: "project", run: run}}
 }
 
 func TestTestConnection_OK(t *testing.T) {
@@ -97,3 +90,26 @@ func TestListPullRequests_Error(t *testing.T) {
 	_, err := c.ListPullRequests("open", 5)
 	require.Error(t, err)
 }
+
+func TestGetPullRequest_JSON(t *testing.T) {
+	c := newTestClientWithRunner(t, func(ctx context.Context, name string, args ...string) (string, string, error) {
+		require.Equal(t, "pr", args[0])
+		require.Equal(t, "view", args[1])
+		require.Equal(t, "42", args[2])
+		return `{"number": 42, "title": "Fix bug", "url": "https://x/pr/42", "headRefName": "feat/x", "baseRefName": "main"}`, "", nil
+	})
+	pr, err := c.GetPullRequest(42)
+	require.NoError(t, err)
+	assert.Equal(t, 42, pr.Number)
+	assert.Equal(t, "feat/x", pr.HeadRefName)
+}
+
+func TestClosePullRequest_OK(t *testing.T) {
+	c := newTestClientWithRunner(t, func(ctx context.Context, name string, args ...string) (string, string, error) {
+		require.Equal(t, "pr", args[0])
+		require.Equal(t, "close", args[1])
+		require.Equal(t, "42", args[2])
+		return "", "", nil
+	})
+	require.NoError(t, c.ClosePullRequest(42))
+}
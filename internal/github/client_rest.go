@@ -0,0 +1,283 @@
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const githubAPIBase = "https://api.github.com"
+
+// restTransport talks to GitHub's REST v3 API directly over HTTP. It
+// avoids the gh CLI's external-binary requirement and ~20s subprocess
+// timeout, and surfaces structured errors (rate limits, 422 validation
+// failures) instead of parsed CLI stderr.
+type restTransport struct {
+	owner      string
+	repo       string
+	token      string
+	baseURL    string
+	httpClient *http.Client
+}
+
+func newRESTTransport(owner, repo, token string) restTransport {
+	return restTransport{
+		owner:   owner,
+		repo:    repo,
+		token:   token,
+		baseURL: githubAPIBase,
+		httpClient: &http.Client{
+			Timeout: 15 * time.Second,
+		},
+	}
+}
+
+func (c restTransport) newRequest(method, path string, body any) (*http.Request, error) {
+	var bodyReader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		bodyReader = bytes.NewReader(b)
+	}
+	req, err := http.NewRequest(method, c.baseURL+path, bodyReader)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return req, nil
+}
+
+func (c restTransport) do(req *http.Request) (*http.Response, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("github: request failed: %w", err)
+	}
+	if resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		return nil, httpError(resp)
+	}
+	return resp, nil
+}
+
+// httpError turns a non-2xx GitHub response into a descriptive error,
+// distinguishing rate limiting and 422 validation failures (e.g.
+// requesting a review from someone who isn't a collaborator) from a
+// generic failure, since callers may want to react to those differently.
+func httpError(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 8<<10))
+
+	if resp.StatusCode == http.StatusForbidden && resp.Header.Get("X-RateLimit-Remaining") == "0" {
+		return fmt.Errorf("github: rate limited, resets at unix %s: %s", resp.Header.Get("X-RateLimit-Reset"), string(body))
+	}
+	if resp.StatusCode == http.StatusUnprocessableEntity {
+		var payload struct {
+			Message string `json:"message"`
+			Errors  []struct {
+				Resource string `json:"resource"`
+				Field    string `json:"field"`
+				Code     string `json:"code"`
+				Message  string `json:"message"`
+			} `json:"errors"`
+		}
+		if err := json.Unmarshal(body, &payload); err == nil && len(payload.Errors) > 0 {
+			details := make([]string, 0, len(payload.Errors))
+			for _, e := range payload.Errors {
+				if e.Message != "" {
+					details = append(details, e.Message)
+				} else {
+					details = append(details, fmt.Sprintf("%s.%s: %s", e.Resource, e.Field, e.Code))
+				}
+			}
+			return fmt.Errorf("github: validation failed: %s: %s", payload.Message, strings.Join(details, "; "))
+		}
+	}
+	return fmt.Errorf("github: request failed: %s: %s", resp.Status, string(body))
+}
+
+func (c restTransport) repoPath(suffix string) string {
+	return fmt.Sprintf("/repos/%s/%s%s", c.owner, c.repo, suffix)
+}
+
+// TestConnection verifies the token is valid by calling /user.
+func (c restTransport) TestConnection() error {
+	req, err := c.newRequest(http.MethodGet, "/user", nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// CreatePullRequest creates a pull request via POST /repos/:owner/:repo/pulls.
+func (c restTransport) CreatePullRequest(title, body, head, base string, draft bool) (string, error) {
+	if strings.TrimSpace(title) == "" {
+		return "", errors.New("github: title is required")
+	}
+	payload := struct {
+		Title string `json:"title"`
+		Body  string `json:"body"`
+		Head  string `json:"head"`
+		Base  string `json:"base"`
+		Draft bool   `json:"draft"`
+	}{Title: title, Body: body, Head: head, Base: base, Draft: draft}
+
+	req, err := c.newRequest(http.MethodPost, c.repoPath("/pulls"), payload)
+	if err != nil {
+		return "", err
+	}
+	resp, err := c.do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		HTMLURL string `json:"html_url"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("github: unable to decode create PR response: %w", err)
+	}
+	if result.HTMLURL == "" {
+		return "", errors.New("github: create PR returned empty URL")
+	}
+	return result.HTMLURL, nil
+}
+
+// GetPullRequest fetches a single pull request via
+// GET /repos/:owner/:repo/pulls/:number.
+func (c restTransport) GetPullRequest(number int) (PullRequest, error) {
+	req, err := c.newRequest(http.MethodGet, c.repoPath(fmt.Sprintf("/pulls/%d", number)), nil)
+	if err != nil {
+		return PullRequest{}, err
+	}
+	resp, err := c.do(req)
+	if err != nil {
+		return PullRequest{}, err
+	}
+	defer resp.Body.Close()
+
+	var pr struct {
+		Number  int    `json:"number"`
+		Title   string `json:"title"`
+		HTMLURL string `json:"html_url"`
+		Head    struct {
+			Ref string `json:"ref"`
+		} `json:"head"`
+		Base struct {
+			Ref string `json:"ref"`
+		} `json:"base"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&pr); err != nil {
+		return PullRequest{}, fmt.Errorf("github: unable to decode get PR response: %w", err)
+	}
+	return PullRequest{
+		Number:      pr.Number,
+		Title:       pr.Title,
+		URL:         pr.HTMLURL,
+		HeadRefName: pr.Head.Ref,
+		BaseRefName: pr.Base.Ref,
+	}, nil
+}
+
+// ClosePullRequest closes a pull request via
+// PATCH /repos/:owner/:repo/pulls/:number.
+func (c restTransport) ClosePullRequest(number int) error {
+	req, err := c.newRequest(http.MethodPatch, c.repoPath(fmt.Sprintf("/pulls/%d", number)), struct {
+		State string `json:"state"`
+	}{State: "closed"})
+	if err != nil {
+		return err
+	}
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// ListPullRequests lists pull requests via GET /repos/:owner/:repo/pulls.
+// State can be one of: open, closed, merged, all. Limit <= 0 means the
+// API's default page size.
+//
+// GitHub's REST API has no "merged" state filter directly: merged PRs
+// are "closed" PRs with a non-null merged_at, so that case is requested
+// as "closed" and filtered client-side.
+func (c restTransport) ListPullRequests(state string, limit int) ([]PullRequest, error) {
+	if state == "" {
+		state = "open"
+	}
+	apiState := state
+	if apiState == "merged" {
+		apiState = "closed"
+	}
+
+	q := url.Values{}
+	q.Set("state", apiState)
+	perPage := limit
+	if perPage <= 0 || perPage > 100 {
+		perPage = 30
+	}
+	q.Set("per_page", fmt.Sprintf("%d", perPage))
+
+	req, err := c.newRequest(http.MethodGet, c.repoPath("/pulls")+"?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var raw []struct {
+		Number   int     `json:"number"`
+		Title    string  `json:"title"`
+		HTMLURL  string  `json:"html_url"`
+		MergedAt *string `json:"merged_at"`
+		Head     struct {
+			Ref string `json:"ref"`
+		} `json:"head"`
+		Base struct {
+			Ref string `json:"ref"`
+		} `json:"base"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("github: unable to decode list PRs response: %w", err)
+	}
+
+	out := make([]PullRequest, 0, len(raw))
+	for _, pr := range raw {
+		if state == "merged" && pr.MergedAt == nil {
+			continue
+		}
+		if limit > 0 && len(out) >= limit {
+			break
+		}
+		out = append(out, PullRequest{
+			Number:      pr.Number,
+			Title:       pr.Title,
+			URL:         pr.HTMLURL,
+			HeadRefName: pr.Head.Ref,
+			BaseRefName: pr.Base.Ref,
+		})
+	}
+	return out, nil
+}
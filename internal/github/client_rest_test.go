@@ -0,0 +1,163 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	appconfig "git-autometa/internal/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRESTTransport(t *testing.T, handler http.HandlerFunc) (restTransport, *httptest.Server) {
+	t.Helper()
+	ts := httptest.NewServer(handler)
+	rt := newRESTTransport("acme", "project", "test-token")
+	rt.baseURL = ts.URL
+	rt.httpClient = ts.Client()
+	return rt, ts
+}
+
+func TestNewClient_PicksTransportByToken(t *testing.T) {
+	withToken := NewClient(appconfig.Config{GitHub: appconfig.GitHubConfig{Owner: "acme", Repo: "project", Token: "abc"}})
+	_, isREST := withToken.transport.(restTransport)
+	assert.True(t, isREST, "expected restTransport when a token is configured")
+
+	t.Setenv("GITHUB_TOKEN", "")
+	withoutToken := NewClient(appconfig.Config{GitHub: appconfig.GitHubConfig{Owner: "acme", Repo: "project"}})
+	_, isCLI := withoutToken.transport.(cliTransport)
+	assert.True(t, isCLI, "expected cliTransport when no token is available")
+}
+
+func TestRESTTestConnection_OK(t *testing.T) {
+	rt, ts := newTestRESTTransport(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/user", r.URL.Path)
+		require.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+		require.Equal(t, "2022-11-28", r.Header.Get("X-GitHub-Api-Version"))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"login":"octocat"}`))
+	})
+	defer ts.Close()
+
+	require.NoError(t, rt.TestConnection())
+}
+
+func TestRESTCreatePullRequest_OK(t *testing.T) {
+	rt, ts := newTestRESTTransport(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/repos/acme/project/pulls", r.URL.Path)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"html_url":"https://github.com/acme/project/pull/42"}`))
+	})
+	defer ts.Close()
+
+	url, err := rt.CreatePullRequest("Title", "Body", "feature/x", "main", true)
+	require.NoError(t, err)
+	assert.Equal(t, "https://github.com/acme/project/pull/42", url)
+}
+
+func TestRESTCreatePullRequest_RequiresTitle(t *testing.T) {
+	rt, ts := newTestRESTTransport(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Fail(t, "server should not be called when title is empty")
+	})
+	defer ts.Close()
+
+	_, err := rt.CreatePullRequest("", "B", "h", "b", false)
+	require.Error(t, err)
+}
+
+func TestRESTCreatePullRequest_ValidationError(t *testing.T) {
+	rt, ts := newTestRESTTransport(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"message":"Validation Failed","errors":[{"resource":"PullRequest","field":"head","code":"invalid","message":"head ref must exist"}]}`))
+	})
+	defer ts.Close()
+
+	_, err := rt.CreatePullRequest("Title", "Body", "missing-branch", "main", false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "head ref must exist")
+}
+
+func TestRESTCreatePullRequest_RateLimited(t *testing.T) {
+	rt, ts := newTestRESTTransport(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", "1700000000")
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"message":"API rate limit exceeded"}`))
+	})
+	defer ts.Close()
+
+	_, err := rt.CreatePullRequest("Title", "Body", "feature/x", "main", false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "rate limited")
+}
+
+func TestRESTListPullRequests_OK(t *testing.T) {
+	rt, ts := newTestRESTTransport(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/repos/acme/project/pulls", r.URL.Path)
+		require.Equal(t, "open", r.URL.Query().Get("state"))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[
+			{"number": 1, "title": "Fix bug", "html_url": "https://x/pr/1", "head": {"ref": "feat/x"}, "base": {"ref": "main"}},
+			{"number": 2, "title": "Feat y", "html_url": "https://x/pr/2", "head": {"ref": "feat/y"}, "base": {"ref": "develop"}}
+		]`))
+	})
+	defer ts.Close()
+
+	prs, err := rt.ListPullRequests("open", 10)
+	require.NoError(t, err)
+	require.Len(t, prs, 2)
+	assert.Equal(t, 1, prs[0].Number)
+	assert.Equal(t, "feat/y", prs[1].HeadRefName)
+}
+
+func TestRESTGetPullRequest_OK(t *testing.T) {
+	rt, ts := newTestRESTTransport(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/repos/acme/project/pulls/42", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"number": 42, "title": "Fix bug", "html_url": "https://x/pr/42", "head": {"ref": "feat/x"}, "base": {"ref": "main"}}`))
+	})
+	defer ts.Close()
+
+	pr, err := rt.GetPullRequest(42)
+	require.NoError(t, err)
+	assert.Equal(t, 42, pr.Number)
+	assert.Equal(t, "feat/x", pr.HeadRefName)
+}
+
+func TestRESTClosePullRequest_OK(t *testing.T) {
+	rt, ts := newTestRESTTransport(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPatch, r.Method)
+		require.Equal(t, "/repos/acme/project/pulls/42", r.URL.Path)
+		var payload struct {
+			State string `json:"state"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		require.Equal(t, "closed", payload.State)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	})
+	defer ts.Close()
+
+	require.NoError(t, rt.ClosePullRequest(42))
+}
+
+func TestRESTListPullRequests_MergedFiltersLocally(t *testing.T) {
+	rt, ts := newTestRESTTransport(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "closed", r.URL.Query().Get("state"))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[
+			{"number": 1, "title": "Merged PR", "html_url": "https://x/pr/1", "merged_at": "2024-01-01T00:00:00Z", "head": {"ref": "feat/a"}, "base": {"ref": "main"}},
+			{"number": 2, "title": "Closed, not merged", "html_url": "https://x/pr/2", "merged_at": null, "head": {"ref": "feat/b"}, "base": {"ref": "main"}}
+		]`))
+	})
+	defer ts.Close()
+
+	prs, err := rt.ListPullRequests("merged", 10)
+	require.NoError(t, err)
+	require.Len(t, prs, 1)
+	assert.Equal(t, 1, prs[0].Number)
+}
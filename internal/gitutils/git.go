@@ -1,31 +1,513 @@
+// Package gitutils is a go-git-backed alternative to internal/git: the
+// same branch/push/log operations, implemented against
+// github.com/go-git/go-git/v5 instead of shelling out to a system git
+// binary. That trades the system dependency for structured errors and a
+// repository layer that's testable against go-git's in-memory storage,
+// without forking a process per call.
+//
+// It isn't wired in as the default yet — internal/git's exec-based
+// implementation remains what the CLI commands use — but exposes the
+// same method set so a future config-selectable backend (git.backend:
+// exec|gogit) can delegate to it directly.
 package gitutils
 
-// Utils is a placeholder wrapper around Git operations.
-type Utils struct{}
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
 
-func NewUtils() *Utils { return &Utils{} }
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
 
-func (g *Utils) PrepareWorkBranch(baseBranchName string) (string, error) {
-	// TODO: implement branch creation and checkout
-	return baseBranchName, nil
+	"git-autometa/internal/auth"
+)
+
+// Utils wraps go-git operations executed against a repository on disk.
+type Utils struct {
+	// WorkDir is the filesystem path of the repository. If empty,
+	// operations run against the current process working directory.
+	WorkDir string
+	// MainBranch, if set, is used by PrepareWorkBranch/DefaultBranch
+	// instead of probing the repository, mirroring internal/git.Git's
+	// field of the same name.
+	MainBranch string
+	// SSH configures PushBranch's SSH authentication, mirroring
+	// internal/git.PushOptions. A zero value uses whatever resolveAuth
+	// already picks up from the ambient ssh-agent/config.
+	SSH PushOptions
+}
+
+// PushOptions pins PushBranch's SSH authentication beyond the ambient
+// environment: a specific private key (optionally passphrase-protected),
+// a known_hosts file, or the running ssh-agent.
+type PushOptions struct {
+	// SSHKeyPath, if set, is loaded as an ssh.PublicKeys auth method
+	// instead of relying on the ambient ssh-agent/~/.ssh/config.
+	SSHKeyPath string
+	// SSHKeyPassphrase decrypts SSHKeyPath, looked up by callers from the
+	// secrets keyring under "ssh:<path>" (secrets.GetSSHKeyPassphrase).
+	SSHKeyPassphrase string
+	// KnownHostsPath overrides the known_hosts file used to verify the
+	// remote's host key. Empty uses go-git's default host key callback.
+	KnownHostsPath string
+	// UseAgent forces ssh.NewSSHAgentAuth instead of a key file, for
+	// passphrase-protected keys already loaded into a running agent.
+	UseAgent bool
+}
+
+func New() *Utils { return &Utils{} }
+
+// NewWithWorkDir creates a Utils bound to the provided working directory.
+func NewWithWorkDir(dir string) *Utils { return &Utils{WorkDir: dir} }
+
+// PrepareWorkBranch ensures the repository is up to date on its default
+// branch (main, falling back to master) and creates/switches to a new
+// work branch derived from desiredBranchName. If that name is already
+// taken locally or on origin, an incrementing numeric suffix (e.g. "-2",
+// "-3", ...) is appended until an unused name is found.
+func (u *Utils) PrepareWorkBranch(desiredBranchName string) (string, error) {
+	repo, err := u.open()
+	if err != nil {
+		return "", err
+	}
+	return prepareWorkBranch(repo, desiredBranchName, u.MainBranch)
 }
 
-func (g *Utils) PushBranch(branchName string) error {
-	// TODO: implement push
+// prepareWorkBranch holds the actual logic against an already-open
+// *git.Repository, so tests can exercise it against go-git's in-memory
+// storage without a repo on disk.
+func prepareWorkBranch(repo *git.Repository, desiredBranchName, mainBranchOverride string) (string, error) {
+	wt, err := repo.Worktree()
+	if err != nil {
+		return "", fmt.Errorf("gitutils: worktree: %w", err)
+	}
+
+	remote, _ := remoteURL(repo, "origin")
+	auth := resolveAuth(remote)
+	// Best-effort, matching internal/git's "fetch --all -p": a repo with
+	// no remote, or one that's unreachable, still has a local
+	// main/master to branch from.
+	_ = repo.Fetch(&git.FetchOptions{RemoteName: "origin", Auth: auth, Prune: true})
+
+	mainBranch, mainRef, err := resolveMainBranch(repo, mainBranchOverride)
+	if err != nil {
+		return "", err
+	}
+	if mainRef != nil {
+		checkoutOpts := &git.CheckoutOptions{Branch: plumbing.NewBranchReferenceName(mainBranch)}
+		if _, err := repo.Reference(checkoutOpts.Branch, true); err != nil {
+			// Local branch doesn't exist yet; create it tracking the
+			// resolved remote/local ref.
+			checkoutOpts.Hash = mainRef.Hash()
+			checkoutOpts.Create = true
+		}
+		if err := wt.Checkout(checkoutOpts); err != nil {
+			return "", fmt.Errorf("gitutils: checkout %q: %w", mainBranch, err)
+		}
+		if err := wt.Pull(&git.PullOptions{RemoteName: "origin", Auth: auth}); err != nil &&
+			!errors.Is(err, git.NoErrAlreadyUpToDate) && !errors.Is(err, git.ErrRemoteNotFound) {
+			// Non-fast-forwardable or offline: proceed with whatever
+			// main currently points at, same as internal/git's
+			// best-effort "pull --ff-only".
+		}
+	}
+
+	finalBranchName := desiredBranchName
+	for branchExists(repo, finalBranchName) {
+		finalBranchName = incrementBranchName(finalBranchName)
+	}
+
+	createOpts := &git.CheckoutOptions{
+		Branch: plumbing.NewBranchReferenceName(finalBranchName),
+		Create: true,
+	}
+	if err := wt.Checkout(createOpts); err != nil {
+		return "", fmt.Errorf("gitutils: create branch %q: %w", finalBranchName, err)
+	}
+	return finalBranchName, nil
+}
+
+// PushBranch pushes the given branch to origin and records it as the
+// branch's upstream, as `git push -u` would.
+func (u *Utils) PushBranch(branchName string) error {
+	repo, err := u.open()
+	if err != nil {
+		return err
+	}
+	return pushBranch(repo, branchName, u.SSH)
+}
+
+func pushBranch(repo *git.Repository, branchName string, opts PushOptions) error {
+	remote, err := repo.Remote("origin")
+	if err != nil {
+		return errors.New("gitutils: no 'origin' remote configured")
+	}
+
+	refSpec := config.RefSpec(fmt.Sprintf("refs/heads/%s:refs/heads/%s", branchName, branchName))
+	originURL := ""
+	if urls := remote.Config().URLs; len(urls) > 0 {
+		originURL = urls[0]
+	}
+	err = repo.Push(&git.PushOptions{
+		RemoteName: "origin",
+		RefSpecs:   []config.RefSpec{refSpec},
+		Auth:       resolveAuthWithOptions(originURL, opts),
+	})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return fmt.Errorf("gitutils: push %q: %w", branchName, err)
+	}
+
+	if cfg, cfgErr := repo.Config(); cfgErr == nil {
+		cfg.Branches[branchName] = &config.Branch{
+			Name:   branchName,
+			Remote: "origin",
+			Merge:  plumbing.NewBranchReferenceName(branchName),
+		}
+		_ = repo.SetConfig(cfg)
+	}
 	return nil
 }
 
-func (g *Utils) GetCurrentBranch() (string, error) {
-	// TODO: implement detection of current branch
-	return "", nil
+// GetCurrentBranch returns the name of the current checked-out branch.
+func (u *Utils) GetCurrentBranch() (string, error) {
+	repo, err := u.open()
+	if err != nil {
+		return "", err
+	}
+	return getCurrentBranch(repo)
+}
+
+func getCurrentBranch(repo *git.Repository) (string, error) {
+	head, err := repo.Head()
+	if err != nil {
+		return "", fmt.Errorf("gitutils: HEAD: %w", err)
+	}
+	if !head.Name().IsBranch() {
+		return "", errors.New("gitutils: HEAD is detached, not on a branch")
+	}
+	return head.Name().Short(), nil
+}
+
+// squareJiraTag and bareJiraTag strip leading JIRA identifiers from
+// commit subjects, matching internal/git's GetCommitMessagesForPR.
+var (
+	squareJiraTag = regexp.MustCompile(`^\[[A-Z][A-Z0-9]+-\d+\]\s*`)
+	bareJiraTag   = regexp.MustCompile(`^[A-Z][A-Z0-9]+-\d+\s*[:\-]?\s*`)
+)
+
+// GetCommitMessagesForPR returns commit subjects between the merge-base
+// of baseBranch and HEAD, and HEAD, with leading JIRA tags like
+// "[ABC-123]" or "ABC-123:" stripped from each message. Merge commits are
+// skipped, mirroring `git log --no-merges`.
+func (u *Utils) GetCommitMessagesForPR(baseBranch string) ([]string, error) {
+	repo, err := u.open()
+	if err != nil {
+		return nil, err
+	}
+	return getCommitMessagesForPR(repo, baseBranch)
+}
+
+func getCommitMessagesForPR(repo *git.Repository, baseBranch string) ([]string, error) {
+	head, err := repo.Head()
+	if err != nil {
+		return nil, fmt.Errorf("gitutils: HEAD: %w", err)
+	}
+	headCommit, err := repo.CommitObject(head.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("gitutils: resolve HEAD commit: %w", err)
+	}
+
+	baseRef, err := resolveBranchRef(repo, baseBranch)
+	if err != nil {
+		return nil, fmt.Errorf("gitutils: resolve base branch %q: %w", baseBranch, err)
+	}
+	baseCommit, err := repo.CommitObject(baseRef.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("gitutils: resolve base commit: %w", err)
+	}
+
+	mergeBases, err := headCommit.MergeBase(baseCommit)
+	if err != nil {
+		return nil, fmt.Errorf("gitutils: merge-base: %w", err)
+	}
+
+	stopAt := map[plumbing.Hash]bool{}
+	for _, c := range mergeBases {
+		stopAt[c.Hash] = true
+	}
+	if len(mergeBases) == 0 {
+		stopAt[baseCommit.Hash] = true
+	}
+
+	var messages []string
+	commit := headCommit
+	for !stopAt[commit.Hash] {
+		if commit.NumParents() <= 1 {
+			cleaned := squareJiraTag.ReplaceAllString(strings.TrimSpace(commit.Message), "")
+			cleaned = bareJiraTag.ReplaceAllString(cleaned, "")
+			cleaned = firstLine(cleaned)
+			if cleaned != "" {
+				messages = append(messages, cleaned)
+			}
+		}
+		if commit.NumParents() == 0 {
+			break
+		}
+		parent, err := commit.Parent(0)
+		if err != nil {
+			return nil, fmt.Errorf("gitutils: walk commit history: %w", err)
+		}
+		commit = parent
+	}
+	if messages == nil {
+		messages = []string{}
+	}
+	return messages, nil
+}
+
+func firstLine(s string) string {
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		return strings.TrimSpace(s[:i])
+	}
+	return s
+}
+
+// DefaultBranch resolves the repository's default branch, in order of
+// preference: refs/remotes/origin/HEAD (what "git clone" sets up
+// automatically, but a bare PlainInit + CreateRemote does not); asking
+// the remote directly for its HEAD branch; then probing for a local or
+// remote "main", falling back to "master". Mirrors internal/git.Git's
+// DefaultBranch, going through go-git primitives instead of shelling out.
+func (u *Utils) DefaultBranch() (string, error) {
+	if u.MainBranch != "" {
+		return u.MainBranch, nil
+	}
+	repo, err := u.open()
+	if err != nil {
+		return "", err
+	}
+	return defaultBranch(repo)
+}
+
+func defaultBranch(repo *git.Repository) (string, error) {
+	if branch, ok := originHEADBranch(repo); ok {
+		return branch, nil
+	}
+	if branch, ok := remoteHEADBranch(repo); ok {
+		return branch, nil
+	}
+	for _, name := range []string{"main", "master"} {
+		if branchExists(repo, name) {
+			return name, nil
+		}
+	}
+	return "", errors.New("gitutils: unable to determine the repository's default branch")
+}
+
+// originHEADBranch reads the local refs/remotes/origin/HEAD symbolic ref,
+// which a plain "git clone" sets up automatically but PlainInit +
+// CreateRemote does not.
+func originHEADBranch(repo *git.Repository) (string, bool) {
+	ref, err := repo.Reference(plumbing.NewRemoteReferenceName("origin", "HEAD"), false)
+	if err != nil || ref.Type() != plumbing.SymbolicReference {
+		return "", false
+	}
+	return strings.TrimPrefix(ref.Target().Short(), "origin/"), true
+}
+
+// remoteHEADBranch asks the remote directly which branch HEAD points at,
+// mirroring internal/git's "git remote show origin" fallback.
+func remoteHEADBranch(repo *git.Repository) (string, bool) {
+	rem, err := repo.Remote("origin")
+	if err != nil {
+		return "", false
+	}
+	originURL := ""
+	if urls := rem.Config().URLs; len(urls) > 0 {
+		originURL = urls[0]
+	}
+	refs, err := rem.List(&git.ListOptions{Auth: resolveAuth(originURL)})
+	if err != nil {
+		return "", false
+	}
+	var headHash plumbing.Hash
+	branchHashes := map[string]plumbing.Hash{}
+	for _, ref := range refs {
+		switch {
+		case ref.Name() == plumbing.HEAD:
+			if ref.Type() == plumbing.SymbolicReference {
+				return ref.Target().Short(), true
+			}
+			headHash = ref.Hash()
+		case ref.Name().IsBranch():
+			branchHashes[ref.Name().Short()] = ref.Hash()
+		}
+	}
+	for _, name := range []string{"main", "master"} {
+		if h, ok := branchHashes[name]; ok && h == headHash {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// GetRemoteURL returns the URL configured for the given remote.
+func (u *Utils) GetRemoteURL(remote string) (string, error) {
+	repo, err := u.open()
+	if err != nil {
+		return "", err
+	}
+	return remoteURL(repo, remote)
+}
+
+func remoteURL(repo *git.Repository, remote string) (string, error) {
+	rem, err := repo.Remote(remote)
+	if err != nil {
+		return "", fmt.Errorf("gitutils: remote %q: %w", remote, err)
+	}
+	urls := rem.Config().URLs
+	if len(urls) == 0 {
+		return "", fmt.Errorf("gitutils: remote %q has no URL", remote)
+	}
+	return urls[0], nil
+}
+
+func (u *Utils) open() (*git.Repository, error) {
+	dir := u.WorkDir
+	if dir == "" {
+		dir = "."
+	}
+	repo, err := git.PlainOpenWithOptions(dir, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return nil, fmt.Errorf("gitutils: open repository at %q: %w", dir, err)
+	}
+	return repo, nil
+}
+
+// resolveMainBranch returns the repo's default branch name and the
+// reference it currently points to — whether that's a local branch or,
+// if only fetched, origin's copy of it. A nil ref with a nil error means
+// the branch doesn't exist yet (e.g. a brand new repo or a not-yet-pushed
+// override), which PrepareWorkBranch treats as "branch from nothing".
+//
+// override, if set, is used as-is instead of probing for "main"/"master"
+// (mirroring internal/git.Git's MainBranch field).
+func resolveMainBranch(repo *git.Repository, override string) (string, *plumbing.Reference, error) {
+	if override != "" {
+		if ref, err := repo.Reference(plumbing.NewBranchReferenceName(override), true); err == nil {
+			return override, ref, nil
+		}
+		if ref, err := repo.Reference(plumbing.NewRemoteReferenceName("origin", override), true); err == nil {
+			return override, ref, nil
+		}
+		return override, nil, nil
+	}
+	for _, name := range []string{"main", "master"} {
+		if ref, err := repo.Reference(plumbing.NewBranchReferenceName(name), true); err == nil {
+			return name, ref, nil
+		}
+		if ref, err := repo.Reference(plumbing.NewRemoteReferenceName("origin", name), true); err == nil {
+			return name, ref, nil
+		}
+	}
+	return "main", nil, nil
+}
+
+func resolveBranchRef(repo *git.Repository, name string) (*plumbing.Reference, error) {
+	if ref, err := repo.Reference(plumbing.NewBranchReferenceName(name), true); err == nil {
+		return ref, nil
+	}
+	if ref, err := repo.Reference(plumbing.NewRemoteReferenceName("origin", name), true); err == nil {
+		return ref, nil
+	}
+	return nil, fmt.Errorf("no such branch %q (checked local and origin)", name)
+}
+
+func branchExists(repo *git.Repository, name string) bool {
+	if _, err := repo.Reference(plumbing.NewBranchReferenceName(name), true); err == nil {
+		return true
+	}
+	_, err := repo.Reference(plumbing.NewRemoteReferenceName("origin", name), true)
+	return err == nil
+}
+
+func incrementBranchName(name string) string {
+	lastDash := strings.LastIndex(name, "-")
+	if lastDash == -1 {
+		return name + "-2"
+	}
+	prefix := name[:lastDash]
+	suffix := name[lastDash+1:]
+	parsed, err := strconv.Atoi(suffix)
+	if err != nil || parsed <= 1 {
+		return name + "-2"
+	}
+	return fmt.Sprintf("%s-%d", prefix, parsed+1)
+}
+
+// resolveAuth picks a go-git transport.AuthMethod for remoteURL: HTTP
+// basic auth from $GIT_HTTP_TOKEN, falling back to a ~/.netrc entry for
+// the remote's host (via auth.ResolveToken), for https:// remotes; the
+// ambient ssh-agent for ssh/git@ remotes. Keyring credentials and
+// explicit SSH key files are handled by the sibling credential-chain and
+// SSH-auth work; this covers the common case of an already-configured
+// agent, token, or netrc entry.
+func resolveAuth(remoteURL string) transport.AuthMethod {
+	return resolveAuthWithOptions(remoteURL, PushOptions{})
 }
 
-func (g *Utils) GetCommitMessagesForPR(baseBranch string) ([]string, error) {
-	// TODO: implement commit message extraction
-	return []string{}, nil
+// resolveAuthWithOptions is resolveAuth with an explicit SSHKeyPath,
+// known_hosts file, or forced agent use layered on top, for PushBranch's
+// --ssh-key support. opts' zero value behaves exactly like resolveAuth.
+func resolveAuthWithOptions(remoteURL string, opts PushOptions) transport.AuthMethod {
+	switch {
+	case strings.HasPrefix(remoteURL, "http://"), strings.HasPrefix(remoteURL, "https://"):
+		token := auth.ResolveToken("", "GIT_HTTP_TOKEN", remoteHost(remoteURL))
+		if token == "" {
+			return nil
+		}
+		return &githttp.BasicAuth{Username: "x-access-token", Password: token}
+	case strings.HasPrefix(remoteURL, "git@"), strings.HasPrefix(remoteURL, "ssh://"):
+		if opts.SSHKeyPath != "" && !opts.UseAgent {
+			keys, err := gitssh.NewPublicKeysFromFile("git", opts.SSHKeyPath, opts.SSHKeyPassphrase)
+			if err != nil {
+				return nil
+			}
+			if opts.KnownHostsPath != "" {
+				if cb, err := gitssh.NewKnownHostsCallback(opts.KnownHostsPath); err == nil {
+					keys.HostKeyCallback = cb
+				}
+			}
+			return keys
+		}
+		if os.Getenv("SSH_AUTH_SOCK") == "" {
+			return nil
+		}
+		sshAuth, err := gitssh.NewSSHAgentAuth("git")
+		if err != nil {
+			return nil
+		}
+		return sshAuth
+	default:
+		return nil
+	}
 }
 
-func (g *Utils) GetRemoteURL(remote string) (string, error) {
-	// TODO: implement reading remote URL
-	return "", nil
+// remoteHost extracts the host from an http(s) remote URL, for the
+// ~/.netrc machine lookup in auth.ResolveToken. An unparsable URL yields
+// "", which auth.ResolveToken treats as "no netrc lookup".
+func remoteHost(remoteURL string) string {
+	u, err := url.Parse(remoteURL)
+	if err != nil {
+		return ""
+	}
+	return u.Host
 }
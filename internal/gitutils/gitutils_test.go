@@ -0,0 +1,195 @@
+package gitutils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestRepo creates an in-memory repository (no filesystem access) with
+// one commit on its initial branch (go-git names it "master").
+func newTestRepo(t *testing.T) (*git.Repository, *git.Worktree) {
+	t.Helper()
+	repo, err := git.Init(memory.NewStorage(), memfs.New())
+	require.NoError(t, err)
+	wt, err := repo.Worktree()
+	require.NoError(t, err)
+	commitFile(t, wt, "README.md", "hello")
+	return repo, wt
+}
+
+func commitFile(t *testing.T, wt *git.Worktree, name, content string) {
+	t.Helper()
+	f, err := wt.Filesystem.Create(name)
+	require.NoError(t, err)
+	_, err = f.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	_, err = wt.Add(name)
+	require.NoError(t, err)
+	_, err = wt.Commit("commit "+name, &git.CommitOptions{
+		Author: &object.Signature{Name: "tester", Email: "tester@example.com", When: time.Now()},
+	})
+	require.NoError(t, err)
+}
+
+func TestGetCurrentBranch(t *testing.T) {
+	repo, _ := newTestRepo(t)
+	branch, err := getCurrentBranch(repo)
+	require.NoError(t, err)
+	assert.Equal(t, "master", branch)
+}
+
+func TestGetRemoteURL(t *testing.T) {
+	repo, _ := newTestRepo(t)
+	_, err := repo.CreateRemote(&gitconfig.RemoteConfig{
+		Name: "origin",
+		URLs: []string{"https://github.com/acme/widgets.git"},
+	})
+	require.NoError(t, err)
+
+	url, err := remoteURL(repo, "origin")
+	require.NoError(t, err)
+	assert.Equal(t, "https://github.com/acme/widgets.git", url)
+}
+
+func TestGetRemoteURL_MissingRemote(t *testing.T) {
+	repo, _ := newTestRepo(t)
+	_, err := remoteURL(repo, "origin")
+	require.Error(t, err)
+}
+
+func TestGetCommitMessagesForPR(t *testing.T) {
+	repo, wt := newTestRepo(t)
+
+	require.NoError(t, wt.Checkout(&git.CheckoutOptions{
+		Branch: "refs/heads/feature/x",
+		Create: true,
+	}))
+	commitFile(t, wt, "a.txt", "a")
+	commitFile(t, wt, "b.txt", "b")
+
+	messages, err := getCommitMessagesForPR(repo, "master")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"commit b.txt", "commit a.txt"}, messages)
+}
+
+func TestGetCommitMessagesForPR_StripsJiraTags(t *testing.T) {
+	repo, wt := newTestRepo(t)
+
+	require.NoError(t, wt.Checkout(&git.CheckoutOptions{
+		Branch: "refs/heads/feature/x",
+		Create: true,
+	}))
+	f, err := wt.Filesystem.Create("a.txt")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	_, err = wt.Add("a.txt")
+	require.NoError(t, err)
+	_, err = wt.Commit("[ABC-123] Fix the thing", &git.CommitOptions{
+		Author: &object.Signature{Name: "tester", Email: "tester@example.com", When: time.Now()},
+	})
+	require.NoError(t, err)
+
+	messages, err := getCommitMessagesForPR(repo, "master")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Fix the thing"}, messages)
+}
+
+func TestGetCommitMessagesForPR_NoNewCommits(t *testing.T) {
+	repo, _ := newTestRepo(t)
+	messages, err := getCommitMessagesForPR(repo, "master")
+	require.NoError(t, err)
+	assert.Empty(t, messages)
+}
+
+func TestDefaultBranch_MainMasterProbeWithNoOrigin(t *testing.T) {
+	repo, _ := newTestRepo(t)
+	branch, err := defaultBranch(repo)
+	require.NoError(t, err)
+	assert.Equal(t, "master", branch)
+}
+
+func TestDefaultBranch_FromOriginHEAD(t *testing.T) {
+	repo, wt := newTestRepo(t)
+	require.NoError(t, wt.Checkout(&git.CheckoutOptions{
+		Branch: "refs/heads/trunk",
+		Create: true,
+	}))
+	_, err := repo.CreateRemote(&gitconfig.RemoteConfig{
+		Name: "origin",
+		URLs: []string{"https://github.com/acme/widgets.git"},
+	})
+	require.NoError(t, err)
+	// Mirrors what "git clone" sets up automatically: a local symbolic
+	// ref recording which branch origin's HEAD points at.
+	require.NoError(t, repo.Storer.SetReference(
+		plumbing.NewSymbolicReference(plumbing.NewRemoteReferenceName("origin", "HEAD"),
+			plumbing.NewRemoteReferenceName("origin", "trunk"))))
+
+	branch, err := defaultBranch(repo)
+	require.NoError(t, err)
+	assert.Equal(t, "trunk", branch)
+}
+
+func TestDefaultBranch_ExplicitMainBranchWins(t *testing.T) {
+	u := &Utils{MainBranch: "trunk"}
+	branch, err := u.DefaultBranch()
+	require.NoError(t, err)
+	assert.Equal(t, "trunk", branch)
+}
+
+func TestPrepareWorkBranch_HonorsMainBranchOverride(t *testing.T) {
+	repo, wt := newTestRepo(t)
+	require.NoError(t, wt.Checkout(&git.CheckoutOptions{
+		Branch: "refs/heads/trunk",
+		Create: true,
+	}))
+
+	branch, err := prepareWorkBranch(repo, "feature/x", "trunk")
+	require.NoError(t, err)
+	assert.Equal(t, "feature/x", branch)
+
+	current, err := getCurrentBranch(repo)
+	require.NoError(t, err)
+	assert.Equal(t, "feature/x", current)
+}
+
+func TestPushBranch_NoOrigin(t *testing.T) {
+	repo, _ := newTestRepo(t)
+	err := pushBranch(repo, "feature/x", PushOptions{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no 'origin' remote configured")
+}
+
+func TestPrepareWorkBranch_CreatesFromMain(t *testing.T) {
+	repo, _ := newTestRepo(t)
+	branch, err := prepareWorkBranch(repo, "feature/x", "")
+	require.NoError(t, err)
+	assert.Equal(t, "feature/x", branch)
+
+	current, err := getCurrentBranch(repo)
+	require.NoError(t, err)
+	assert.Equal(t, "feature/x", current)
+}
+
+func TestPrepareWorkBranch_AutoIncrementsOnConflict(t *testing.T) {
+	repo, wt := newTestRepo(t)
+	require.NoError(t, wt.Checkout(&git.CheckoutOptions{
+		Branch: "refs/heads/feature/x",
+		Create: true,
+	}))
+	require.NoError(t, wt.Checkout(&git.CheckoutOptions{Branch: "refs/heads/master"}))
+
+	branch, err := prepareWorkBranch(repo, "feature/x", "")
+	require.NoError(t, err)
+	assert.Equal(t, "feature/x-2", branch)
+}
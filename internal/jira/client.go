@@ -1,22 +1,28 @@
 package jira
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/cookiejar"
 	"net/url"
+	"os"
 	"path"
 	"strings"
+	"sync"
 	"time"
 
+	"git-autometa/internal/auth"
 	appconfig "git-autometa/internal/config"
 	"git-autometa/internal/secrets"
 )
 
-// Note: secrets are retrieved via the centralized secrets package.
+// Note: secrets are retrieved via the centralized secrets package, or via
+// internal/auth when a credential ID is supplied.
 
 // Client provides minimal Jira REST API access required by the CLI.
 type Client struct {
@@ -24,6 +30,25 @@ type Client struct {
 	email      string
 	httpClient *http.Client
 	token      string
+
+	authMode string
+	oauth1   oauth1Credentials
+	// session holds the mutable cookie-login state for AuthMode "session".
+	// It's a pointer so every Client value copied from the same constructor
+	// call shares one login and one mutex, instead of each copy relogging in.
+	session *sessionAuth
+}
+
+// sessionAuth holds the JIRA Server username/password pair and the
+// resulting JSESSIONID cookie state for AuthMode "session". All access is
+// guarded by mu so concurrent requests don't stampede the login endpoint
+// when the session has expired.
+type sessionAuth struct {
+	mu       sync.Mutex
+	username string
+	password string
+	cookies  []*http.Cookie
+	loggedIn bool
 }
 
 func NewClient(cfg appconfig.Config, token string) Client {
@@ -33,20 +58,227 @@ func NewClient(cfg appconfig.Config, token string) Client {
 		httpClient: &http.Client{
 			Timeout: 15 * time.Second,
 		},
-		token: token,
+		token:    token,
+		authMode: effectiveAuthMode(cfg),
+	}
+}
+
+// NewOAuth1Client builds a Client authenticating with three-legged OAuth 1.0a,
+// signing every request with the consumer's RSA private key. token and
+// tokenSecret are the access token pair obtained from the handshake
+// performed by `git-autometa config oauth-setup`.
+func NewOAuth1Client(cfg appconfig.Config, token, tokenSecret string) (Client, error) {
+	if cfg.Jira.ConsumerKey == "" {
+		return Client{}, errors.New("jira: missing consumer key in config")
+	}
+	keyBytes, err := os.ReadFile(cfg.Jira.PrivateKeyPath)
+	if err != nil {
+		return Client{}, fmt.Errorf("jira: unable to read oauth1 private key: %w", err)
+	}
+	privateKey, err := loadRSAPrivateKey(keyBytes)
+	if err != nil {
+		return Client{}, err
+	}
+	return Client{
+		serverURL: strings.TrimRight(cfg.Jira.ServerURL, "/"),
+		httpClient: &http.Client{
+			Timeout: 15 * time.Second,
+		},
+		authMode: appconfig.JiraAuthModeOAuth1,
+		oauth1: oauth1Credentials{
+			consumerKey: cfg.Jira.ConsumerKey,
+			privateKey:  privateKey,
+			token:       token,
+			tokenSecret: tokenSecret,
+		},
+	}, nil
+}
+
+// NewSessionClient builds a Client authenticating against a JIRA Server
+// deployment via the classic /rest/auth/1/session cookie login, for
+// instances that don't offer personal API tokens. The session is
+// established lazily on the first request and transparently re-established
+// once if it expires (a 401 response).
+func NewSessionClient(cfg appconfig.Config, username, password string) (Client, error) {
+	if username == "" || password == "" {
+		return Client{}, errors.New("jira: missing username or password for session auth")
 	}
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return Client{}, fmt.Errorf("jira: unable to create cookie jar: %w", err)
+	}
+	return Client{
+		serverURL: strings.TrimRight(cfg.Jira.ServerURL, "/"),
+		httpClient: &http.Client{
+			Timeout: 15 * time.Second,
+			Jar:     jar,
+		},
+		authMode: appconfig.JiraAuthModeSession,
+		session:  &sessionAuth{username: username, password: password},
+	}, nil
 }
 
-// NewClientWithKeyring is a convenience constructor that reads the token once from keyring.
+// authModeBearer is an internal-only Client.authMode value for credentials
+// resolved through internal/auth (a jira-token personal access token, or a
+// jira-oauth2 access token). It has no corresponding appconfig.JiraConfig
+// field because those credentials are selected per-repo via
+// Auth.JiraCredentialID rather than the single-identity AuthMode setting.
+const authModeBearer = "bearer"
+
+// NewTokenClient builds a Client authenticating with a bearer token: a
+// JIRA Server/Data Center personal access token, or an OAuth 2.0 access
+// token (e.g. an Atlassian Cloud 3LO token), sent as
+// "Authorization: Bearer <token>". Unlike NewSessionClient there is no
+// login step, and unlike an oauth2 credential there is no refresh step;
+// callers that need token rotation use internal/auth.RefreshOAuth2 before
+// constructing the Client (see NewClientWithCredential).
+func NewTokenClient(cfg appconfig.Config, token string) (Client, error) {
+	if token == "" {
+		return Client{}, errors.New("jira: missing bearer token")
+	}
+	return Client{
+		serverURL: strings.TrimRight(cfg.Jira.ServerURL, "/"),
+		httpClient: &http.Client{
+			Timeout: 15 * time.Second,
+		},
+		authMode: authModeBearer,
+		token:    token,
+	}, nil
+}
+
+// NewClientWithKeyring is a convenience constructor that reads the configured
+// credentials from the keyring and builds a Client for cfg.Jira.AuthMode.
+// If cfg.Auth.JiraCredentialID is set, it is used to resolve the identity
+// via the internal/auth credential store instead of the legacy single-token
+// keyring entries; see NewClientWithCredential.
 func NewClientWithKeyring(cfg appconfig.Config) (Client, error) {
-	if cfg.Jira.Email == "" {
-		return Client{}, errors.New("jira: missing email in config")
+	if cfg.Auth.JiraCredentialID != "" {
+		return NewClientWithCredential(cfg, cfg.Auth.JiraCredentialID)
+	}
+
+	switch effectiveAuthMode(cfg) {
+	case appconfig.JiraAuthModeOAuth1:
+		token, secret, err := secrets.GetJiraOAuth1Token(cfg.Jira.ConsumerKey)
+		if err != nil {
+			return Client{}, fmt.Errorf("jira: unable to load oauth1 token from keyring: %w", err)
+		}
+		return NewOAuth1Client(cfg, token, secret)
+	case appconfig.JiraAuthModeSession:
+		if cfg.Jira.Username == "" {
+			return Client{}, errors.New("jira: missing username in config")
+		}
+		password, err := secrets.GetJiraSessionPassword(cfg.Jira.Username)
+		if err != nil {
+			return Client{}, fmt.Errorf("jira: unable to load session password from keyring for %s: %w", cfg.Jira.Username, err)
+		}
+		return NewSessionClient(cfg, cfg.Jira.Username, password)
+	default:
+		if cfg.Jira.Email == "" {
+			return Client{}, errors.New("jira: missing email in config")
+		}
+		if token := auth.ResolveToken("", "JIRA_API_TOKEN", jiraHost(cfg)); token != "" {
+			return NewClient(cfg, token), nil
+		}
+		token, err := secrets.GetJiraToken(cfg)
+		if err != nil {
+			return Client{}, fmt.Errorf("jira: unable to load API token from keyring, a git credential helper, ~/.netrc, or $GIT_AUTOMETA_JIRA_TOKEN for %s: %w", cfg.Jira.Email, err)
+		}
+		return NewClient(cfg, token), nil
+	}
+}
+
+// NewClientWithCredential builds a Client from a credential stored in the
+// internal/auth credential store. An empty credentialID falls back to the
+// default jira-basic or jira-oauth1 credential for cfg.Jira.ServerURL,
+// whichever was registered. This is how a machine with several JIRA
+// instances configured selects the right identity per repo.
+func NewClientWithCredential(cfg appconfig.Config, credentialID string) (Client, error) {
+	store, err := auth.NewStore()
+	if err != nil {
+		return Client{}, err
+	}
+
+	var cred auth.Credential
+	if credentialID != "" {
+		cred, err = store.Get(credentialID)
+	} else {
+		cred, err = store.Default(auth.KindJiraOAuth1, cfg.Jira.ServerURL)
+		if err != nil {
+			cred, err = store.Default(auth.KindJiraBasic, cfg.Jira.ServerURL)
+		}
 	}
-	token, err := secrets.GetJiraToken(cfg.Jira.Email)
 	if err != nil {
-		return Client{}, fmt.Errorf("jira: unable to load API token from keyring for %s: %w", cfg.Jira.Email, err)
+		return Client{}, fmt.Errorf("jira: unable to resolve credential: %w", err)
 	}
-	return NewClient(cfg, token), nil
+
+	secret, err := store.Secret(cred.ID())
+	if err != nil {
+		return Client{}, err
+	}
+
+	switch cred.Kind() {
+	case auth.KindJiraOAuth1:
+		credCfg := cfg
+		credCfg.Jira.ServerURL = cred.Target()
+		credCfg.Jira.ConsumerKey = cred.Metadata()["consumer_key"]
+		credCfg.Jira.PrivateKeyPath = cred.Metadata()["private_key_path"]
+		token, tokenSecret, ok := strings.Cut(secret, ":")
+		if !ok {
+			return Client{}, errors.New("jira: malformed oauth1 credential secret")
+		}
+		return NewOAuth1Client(credCfg, token, tokenSecret)
+	case auth.KindJiraBasic:
+		credCfg := cfg
+		credCfg.Jira.ServerURL = cred.Target()
+		credCfg.Jira.Email = cred.Metadata()["email"]
+		return NewClient(credCfg, secret), nil
+	case auth.KindJiraSession:
+		credCfg := cfg
+		credCfg.Jira.ServerURL = cred.Target()
+		credCfg.Jira.Username = cred.Metadata()["username"]
+		return NewSessionClient(credCfg, credCfg.Jira.Username, secret)
+	case auth.KindJiraToken:
+		credCfg := cfg
+		credCfg.Jira.ServerURL = cred.Target()
+		return NewTokenClient(credCfg, secret)
+	case auth.KindJiraOAuth2:
+		credCfg := cfg
+		credCfg.Jira.ServerURL = cred.Target()
+		tok, err := auth.DecodeOAuth2Token(secret)
+		if err != nil {
+			return Client{}, err
+		}
+		if tok.Expired() {
+			md := cred.Metadata()
+			tok, err = auth.RefreshOAuth2(store, cred, md["client_id"], md["client_secret"], md["token_endpoint"])
+			if err != nil {
+				return Client{}, fmt.Errorf("jira: unable to refresh oauth2 token: %w", err)
+			}
+		}
+		return NewTokenClient(credCfg, tok.AccessToken)
+	default:
+		return Client{}, fmt.Errorf("jira: credential %q has unsupported kind %q", cred.ID(), cred.Kind())
+	}
+}
+
+// effectiveAuthMode defaults an empty AuthMode to basic auth, for
+// configs written before AuthMode existed.
+func effectiveAuthMode(cfg appconfig.Config) string {
+	if cfg.Jira.AuthMode == "" {
+		return appconfig.JiraAuthModeBasic
+	}
+	return cfg.Jira.AuthMode
+}
+
+// jiraHost extracts the host from cfg.Jira.ServerURL, for the ~/.netrc
+// machine lookup in auth.ResolveToken. An unparsable URL yields "",
+// which auth.ResolveToken treats as "no netrc lookup".
+func jiraHost(cfg appconfig.Config) string {
+	u, err := url.Parse(cfg.Jira.ServerURL)
+	if err != nil {
+		return ""
+	}
+	return u.Host
 }
 
 // TestConnection verifies credentials by calling Jira's /myself endpoint.
@@ -55,7 +287,7 @@ func (c Client) TestConnection() error {
 	if err != nil {
 		return err
 	}
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(req, nil)
 	if err != nil {
 		return err
 	}
@@ -69,8 +301,13 @@ func (c Client) TestConnection() error {
 
 // SearchMyIssues searches for issues assigned to the current user, excluding Done, ordered by last update.
 func (c Client) SearchMyIssues(limit int) ([]Issue, error) {
+	return c.SearchIssues("assignee = currentUser() AND statusCategory != Done ORDER BY updated DESC", limit)
+}
+
+// SearchIssues runs an arbitrary JQL query, capped at limit results.
+func (c Client) SearchIssues(jql string, limit int) ([]Issue, error) {
 	q := url.Values{}
-	q.Set("jql", "assignee = currentUser() AND statusCategory != Done ORDER BY updated DESC")
+	q.Set("jql", jql)
 	q.Set("maxResults", fmt.Sprintf("%d", limit))
 	q.Set("fields", "summary,description,issuetype,status,assignee")
 
@@ -78,7 +315,7 @@ func (c Client) SearchMyIssues(limit int) ([]Issue, error) {
 	if err != nil {
 		return nil, err
 	}
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(req, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -136,13 +373,13 @@ func (c Client) GetIssue(key string) (*Issue, error) {
 	}
 
 	q := url.Values{}
-	q.Set("fields", "summary,description,issuetype,status,assignee")
+	q.Set("fields", "summary,description,issuetype,status,assignee,attachment")
 	endpoint := "/rest/api/2/issue/" + url.PathEscape(key)
 	req, err := c.newRequest(http.MethodGet, endpoint, q)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(req, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -166,6 +403,10 @@ func (c Client) GetIssue(key string) (*Issue, error) {
 			Assignee *struct {
 				DisplayName string `json:"displayName"`
 			} `json:"assignee"`
+			Attachment []struct {
+				Filename string `json:"filename"`
+				Content  string `json:"content"`
+			} `json:"attachment"`
 		} `json:"fields"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
@@ -176,6 +417,10 @@ func (c Client) GetIssue(key string) (*Issue, error) {
 	if payload.Fields.Assignee != nil {
 		assignee = payload.Fields.Assignee.DisplayName
 	}
+	attachments := make([]Attachment, 0, len(payload.Fields.Attachment))
+	for _, a := range payload.Fields.Attachment {
+		attachments = append(attachments, Attachment{Filename: a.Filename, URL: a.Content})
+	}
 	result := &Issue{
 		Key:         payload.Key,
 		Summary:     payload.Fields.Summary,
@@ -184,6 +429,8 @@ func (c Client) GetIssue(key string) (*Issue, error) {
 		Status:      payload.Fields.Status.Name,
 		Assignee:    assignee,
 		URL:         c.issueURL(payload.Key),
+		Attachments: attachments,
+		fetch:       c.downloadAttachment,
 	}
 	return result, nil
 }
@@ -203,18 +450,177 @@ func (c Client) newRequest(method, p string, query url.Values) (*http.Request, e
 		return nil, err
 	}
 	req.Header.Set("Accept", "application/json")
-	// Jira Cloud uses basic auth with email:token
-	if c.email == "" {
-		return nil, errors.New("jira: missing email in client")
+	if method != http.MethodGet {
+		// Bypass JIRA's XSRF check, required for session-cookie auth and
+		// harmless for the other auth modes.
+		req.Header.Set("X-Atlassian-Token", "no-check")
 	}
-	if c.token == "" {
-		return nil, errors.New("jira: missing token in client")
+	if err := c.applyAuth(req); err != nil {
+		return nil, err
 	}
-	auth := base64.StdEncoding.EncodeToString([]byte(c.email + ":" + c.token))
-	req.Header.Set("Authorization", "Basic "+auth)
 	return req, nil
 }
 
+// applyAuth sets the Authorization (or equivalent) header/signature on req
+// for the client's configured AuthMode. Shared by newRequest, which builds
+// requests against relative API paths, and downloadAttachment, which
+// requests an absolute attachment content URL.
+func (c Client) applyAuth(req *http.Request) error {
+	switch c.authMode {
+	case appconfig.JiraAuthModeOAuth1:
+		return signOAuth1Request(req, c.oauth1)
+	case appconfig.JiraAuthModeSession:
+		// Authentication rides on the cookie jar set up by NewSessionClient;
+		// do() establishes the session lazily before this request is sent.
+		return nil
+	case authModeBearer:
+		if c.token == "" {
+			return errors.New("jira: missing token in client")
+		}
+		req.Header.Set("Authorization", "Bearer "+c.token)
+		return nil
+	default:
+		// Jira Cloud uses basic auth with email:token
+		if c.email == "" {
+			return errors.New("jira: missing email in client")
+		}
+		if c.token == "" {
+			return errors.New("jira: missing token in client")
+		}
+		auth := base64.StdEncoding.EncodeToString([]byte(c.email + ":" + c.token))
+		req.Header.Set("Authorization", "Basic "+auth)
+		return nil
+	}
+}
+
+// downloadAttachment fetches the raw bytes of an attachment from its
+// authenticated content URL (as returned in a JIRA issue's "attachment"
+// field), reusing the client's configured auth instead of a relative
+// endpoint path the way newRequest's callers do.
+func (c Client) downloadAttachment(contentURL string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, contentURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.applyAuth(req); err != nil {
+		return nil, err
+	}
+	resp, err := c.do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4<<10))
+		return nil, fmt.Errorf("jira: download attachment failed: %s: %s", resp.Status, string(body))
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// do sends req, lazily establishing a JIRA Server session on the first
+// request when authMode is "session", and transparently re-authenticating
+// once if the session has expired (a 401 response) before giving up and
+// returning it to the caller. bodyBytes is the request body to replay on
+// retry, or nil for bodyless requests.
+func (c Client) do(req *http.Request, bodyBytes []byte) (*http.Response, error) {
+	if c.authMode == appconfig.JiraAuthModeSession {
+		if err := c.ensureSession(); err != nil {
+			return nil, err
+		}
+		c.attachSessionCookies(req)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if c.authMode != appconfig.JiraAuthModeSession || resp.StatusCode != http.StatusUnauthorized {
+		return resp, nil
+	}
+	resp.Body.Close()
+
+	if err := c.relogin(); err != nil {
+		return nil, err
+	}
+	retry := req.Clone(req.Context())
+	if bodyBytes != nil {
+		retry.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+		retry.ContentLength = int64(len(bodyBytes))
+	}
+	retry.Header.Del("Cookie")
+	c.attachSessionCookies(retry)
+	return c.httpClient.Do(retry)
+}
+
+// attachSessionCookies copies the cookies captured at login directly onto
+// req. JIRA Server's /rest/auth/1/session sets JSESSIONID scoped to the
+// /rest/auth/1/ path by default (RFC 6265 path matching), so the cookie
+// jar never attaches it to requests under /rest/api/2/... on its own;
+// attaching it explicitly here is what actually authenticates them.
+func (c Client) attachSessionCookies(req *http.Request) {
+	c.session.mu.Lock()
+	defer c.session.mu.Unlock()
+	for _, ck := range c.session.cookies {
+		req.AddCookie(ck)
+	}
+}
+
+// ensureSession logs in if no session has been established yet.
+func (c Client) ensureSession() error {
+	c.session.mu.Lock()
+	defer c.session.mu.Unlock()
+	if c.session.loggedIn {
+		return nil
+	}
+	return c.login()
+}
+
+// relogin unconditionally re-authenticates, used after a 401 response.
+// Guarded by the same mutex as ensureSession so concurrent callers that hit
+// a 401 at the same time don't each hammer the login endpoint.
+func (c Client) relogin() error {
+	c.session.mu.Lock()
+	defer c.session.mu.Unlock()
+	return c.login()
+}
+
+// login must be called with c.session.mu held.
+func (c Client) login() error {
+	payload, err := json.Marshal(map[string]string{
+		"username": c.session.username,
+		"password": c.session.password,
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, c.serverURL+"/rest/auth/1/session", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("X-Atlassian-Token", "no-check")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("jira: session login failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4<<10))
+		return fmt.Errorf("jira: session login failed: %s: %s", resp.Status, string(body))
+	}
+	if c.httpClient.Jar != nil {
+		// Query the jar with the login request's own URL, not the server
+		// root: JIRA Server's /rest/auth/1/session sets JSESSIONID scoped
+		// to the /rest/auth/1/ path by default (RFC 6265 path matching),
+		// so Jar.Cookies(serverRoot) would miss it just the same as any
+		// later request under /rest/api/2/... does.
+		c.session.cookies = c.httpClient.Jar.Cookies(req.URL)
+	}
+	c.session.loggedIn = true
+	return nil
+}
+
 func (c Client) issueURL(key string) string {
 	return strings.TrimRight(c.serverURL, "/") + "/browse/" + key
 }
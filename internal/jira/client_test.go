@@ -1,9 +1,13 @@
 package jira
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
+	"net/http/cookiejar"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
 	appconfig "git-autometa/internal/config"
@@ -89,6 +93,98 @@ func TestSearchMyIssues(t *testing.T) {
 	assert.NotEmpty(t, got.URL)
 }
 
+func TestNewSessionClient_LazyLoginThenReusesCookie(t *testing.T) {
+	var logins int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/auth/1/session":
+			logins++
+			require.Equal(t, http.MethodPost, r.Method)
+			require.Equal(t, "no-check", r.Header.Get("X-Atlassian-Token"))
+			http.SetCookie(w, &http.Cookie{Name: "JSESSIONID", Value: "abc123"})
+			w.WriteHeader(http.StatusOK)
+		case "/rest/api/2/myself":
+			cookie, err := r.Cookie("JSESSIONID")
+			require.NoError(t, err)
+			require.Equal(t, "abc123", cookie.Value)
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"self":"ok"}`))
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	cfg := appconfig.Config{Jira: appconfig.JiraConfig{ServerURL: ts.URL}}
+	client, err := NewSessionClient(cfg, "alice", "hunter2")
+	require.NoError(t, err)
+	client.httpClient = ts.Client()
+	client.httpClient.Jar, _ = cookiejar.New(nil)
+
+	require.NoError(t, client.TestConnection())
+	require.NoError(t, client.TestConnection())
+	assert.Equal(t, 1, logins, "expected the session to be established once and reused")
+}
+
+func TestNewSessionClient_ReloginsOn401(t *testing.T) {
+	var logins, myselfCalls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/auth/1/session":
+			logins++
+			http.SetCookie(w, &http.Cookie{Name: "JSESSIONID", Value: "abc123"})
+			w.WriteHeader(http.StatusOK)
+		case "/rest/api/2/myself":
+			myselfCalls++
+			if myselfCalls == 1 {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"self":"ok"}`))
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	cfg := appconfig.Config{Jira: appconfig.JiraConfig{ServerURL: ts.URL}}
+	client, err := NewSessionClient(cfg, "alice", "hunter2")
+	require.NoError(t, err)
+	client.httpClient = ts.Client()
+	client.httpClient.Jar, _ = cookiejar.New(nil)
+
+	require.NoError(t, client.TestConnection())
+	assert.Equal(t, 2, logins, "expected a re-login after the 401")
+}
+
+func TestNewSessionClient_MissingCredentials(t *testing.T) {
+	_, err := NewSessionClient(appconfig.Config{}, "", "hunter2")
+	require.Error(t, err)
+}
+
+func TestNewTokenClient_SetsBearerHeader(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/rest/api/2/myself", r.URL.Path)
+		require.Equal(t, "Bearer pat-123", r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"self":"ok"}`))
+	}))
+	defer ts.Close()
+
+	cfg := appconfig.Config{Jira: appconfig.JiraConfig{ServerURL: ts.URL}}
+	client, err := NewTokenClient(cfg, "pat-123")
+	require.NoError(t, err)
+	client.httpClient = ts.Client()
+
+	require.NoError(t, client.TestConnection())
+}
+
+func TestNewTokenClient_MissingToken(t *testing.T) {
+	_, err := NewTokenClient(appconfig.Config{}, "")
+	require.Error(t, err)
+}
+
 func TestGetIssue(t *testing.T) {
 	payload := map[string]any{
 		"key": "PROJ-2",
@@ -117,3 +213,49 @@ func TestGetIssue(t *testing.T) {
 	assert.Equal(t, "John", iss.Assignee)
 	assert.NotEmpty(t, iss.URL)
 }
+
+func TestGetIssue_Attachments(t *testing.T) {
+	var serverURL string
+	client, ts := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/rest/api/2/issue/PROJ-3" {
+			payload := map[string]any{
+				"key": "PROJ-3",
+				"fields": map[string]any{
+					"summary":     "Has attachments",
+					"description": "!screenshot.png!",
+					"issuetype":   map[string]any{"name": "Bug"},
+					"status":      map[string]any{"name": "To Do"},
+					"assignee":    map[string]any{"displayName": "John"},
+					"attachment": []map[string]any{
+						{"filename": "screenshot.png", "content": serverURL + "/secure/attachment/10000/screenshot.png"},
+					},
+				},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(payload)
+			return
+		}
+		if r.URL.Path == "/secure/attachment/10000/screenshot.png" {
+			w.Write([]byte("fake-bytes"))
+			return
+		}
+		t.Fatalf("unexpected path: %s", r.URL.Path)
+	})
+	defer ts.Close()
+	serverURL = ts.URL
+
+	iss, err := client.GetIssue("PROJ-3")
+	require.NoError(t, err)
+	require.Len(t, iss.Attachments, 1)
+	assert.Equal(t, "screenshot.png", iss.Attachments[0].Filename)
+
+	dir := t.TempDir()
+	materialized, err := iss.MaterializeAttachments(context.Background(), dir)
+	require.NoError(t, err)
+	require.Len(t, materialized, 1)
+
+	data, err := os.ReadFile(filepath.Join(dir, "screenshot.png"))
+	require.NoError(t, err)
+	assert.Equal(t, "fake-bytes", string(data))
+	assert.Contains(t, iss.Description, filepath.Join(dir, "screenshot.png"))
+}
@@ -1,9 +1,15 @@
 package jira
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
 	"strings"
 
 	"git-autometa/internal/markdown"
+	"git-autometa/internal/slug"
 )
 
 // Issue mirrors essential fields from JIRA responses we care about.
@@ -15,6 +21,20 @@ type Issue struct {
 	Status      string
 	Assignee    string
 	URL         string
+	Attachments []Attachment
+
+	// fetch downloads authenticated attachment content. Set by the Client
+	// that produced this Issue (GetIssue), so MaterializeAttachments
+	// doesn't need its own credentials.
+	fetch func(contentURL string) ([]byte, error)
+}
+
+// Attachment describes a file attached to a JIRA issue.
+type Attachment struct {
+	Filename string
+	// URL is the attachment's authenticated content URL on the JIRA
+	// server, as returned by the issue's "attachment" field.
+	URL string
 }
 
 // DescriptionMarkdown converts the JIRA markup to Markdown.
@@ -22,22 +42,60 @@ func (i *Issue) DescriptionMarkdown() string {
 	return markdown.ConvertJiraToMarkdown(i.Description)
 }
 
-// SlugifyTitle returns a basic slugified title limited to maxLength.
-func (i *Issue) SlugifyTitle(maxLength int) string {
+// SlugifyTitle slugs the issue's summary per opts. See internal/slug for
+// how non-ASCII titles are transliterated rather than gutted.
+func (i *Issue) SlugifyTitle(opts slug.Options) string {
 	if i.Summary == "" {
 		return ""
 	}
-	s := strings.ToLower(i.Summary)
-	s = strings.ReplaceAll(s, " ", "-")
-	// trim to letters, numbers, dashes only (basic scaffolding)
-	cleaned := make([]rune, 0, len(s))
-	for _, r := range s {
-		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '-' {
-			cleaned = append(cleaned, r)
-		}
+	return slug.Make(i.Summary, opts)
+}
+
+// attachmentRef matches the filename token a Jira image/attachment macro
+// was converted to by markdown.ConvertJiraToMarkdown: "![name](name)" for
+// "!name|...!" and "[name](name)" for "[^name]".
+var attachmentRef = regexp.MustCompile(`!?\[([^\]]+)\]\(([^)]+)\)`)
+
+// MaterializeAttachments downloads every attachment referenced in the
+// issue's description (via Jira's "!file.png!" embed or "[^file.pdf]"
+// reference syntax) into dir, through the authenticated client that
+// fetched this Issue. It rewrites Description in place so a later
+// DescriptionMarkdown() call emits links pointing at the downloaded local
+// files instead of bare filenames, and returns the attachments it wrote.
+func (i *Issue) MaterializeAttachments(ctx context.Context, dir string) ([]Attachment, error) {
+	if i.fetch == nil || len(i.Attachments) == 0 {
+		return nil, nil
+	}
+	byName := make(map[string]Attachment, len(i.Attachments))
+	for _, a := range i.Attachments {
+		byName[a.Filename] = a
 	}
-	if maxLength > 0 && len(cleaned) > maxLength {
-		cleaned = cleaned[:maxLength]
+
+	referenced := attachmentRef.FindAllStringSubmatch(i.DescriptionMarkdown(), -1)
+	var materialized []Attachment
+	seen := map[string]bool{}
+	for _, m := range referenced {
+		name := m[2]
+		att, ok := byName[name]
+		if !ok || seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		if err := ctx.Err(); err != nil {
+			return materialized, err
+		}
+		data, err := i.fetch(att.URL)
+		if err != nil {
+			return materialized, fmt.Errorf("jira: download attachment %q: %w", att.Filename, err)
+		}
+		localPath := filepath.Join(dir, att.Filename)
+		if err := os.WriteFile(localPath, data, 0o644); err != nil {
+			return materialized, fmt.Errorf("jira: write attachment %q: %w", att.Filename, err)
+		}
+
+		i.Description = strings.ReplaceAll(i.Description, att.Filename, localPath)
+		materialized = append(materialized, Attachment{Filename: att.Filename, URL: localPath})
 	}
-	return string(cleaned)
+	return materialized, nil
 }
@@ -0,0 +1,274 @@
+package jira
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// oauth1Credentials holds everything required to sign a request with
+// three-legged OAuth 1.0a (RFC 5849) against a JIRA Server Application Link.
+type oauth1Credentials struct {
+	consumerKey string
+	privateKey  *rsa.PrivateKey
+	token       string
+	tokenSecret string
+}
+
+// OAuth1Key wraps the RSA key pair used to sign OAuth 1.0a requests, keeping
+// the crypto/rsa type out of the package's exported surface.
+type OAuth1Key struct {
+	private *rsa.PrivateKey
+}
+
+// GenerateOAuth1Key creates a fresh 2048-bit RSA key pair, used by the
+// `config oauth-setup` wizard the first time it runs for a consumer.
+func GenerateOAuth1Key() (*OAuth1Key, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, fmt.Errorf("jira: unable to generate oauth1 key: %w", err)
+	}
+	return &OAuth1Key{private: key}, nil
+}
+
+// LoadOAuth1Key reads a PEM-encoded PKCS#1 or PKCS#8 RSA private key from disk.
+func LoadOAuth1Key(pemBytes []byte) (*OAuth1Key, error) {
+	key, err := loadRSAPrivateKey(pemBytes)
+	if err != nil {
+		return nil, err
+	}
+	return &OAuth1Key{private: key}, nil
+}
+
+func loadRSAPrivateKey(pemBytes []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, errors.New("jira: no PEM block found in private key file")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("jira: unable to parse RSA private key: %w", err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("jira: private key is not an RSA key")
+	}
+	return rsaKey, nil
+}
+
+// EncodePrivateKeyPEM serializes the RSA private key as PKCS#1 PEM, suitable
+// for persisting to PrivateKeyPath.
+func (k *OAuth1Key) EncodePrivateKeyPEM() []byte {
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(k.private),
+	})
+}
+
+// EncodePublicKeyPEM serializes the RSA public key half as a PEM-encoded
+// PKCS#1 public key, the format JIRA's Application Link setup expects.
+func (k *OAuth1Key) EncodePublicKeyPEM() []byte {
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "PUBLIC KEY",
+		Bytes: x509.MarshalPKCS1PublicKey(&k.private.PublicKey),
+	})
+}
+
+// signOAuth1Request signs req in place, adding an "Authorization: OAuth ..."
+// header built from the normalized parameter string per RFC 5849 section 3.4,
+// using RSA-SHA1 as the signature method.
+func signOAuth1Request(req *http.Request, creds oauth1Credentials) error {
+	if creds.consumerKey == "" {
+		return errors.New("jira: missing oauth1 consumer key")
+	}
+	if creds.privateKey == nil {
+		return errors.New("jira: missing oauth1 private key")
+	}
+
+	params := map[string]string{
+		"oauth_consumer_key":     creds.consumerKey,
+		"oauth_nonce":            oauthNonce(),
+		"oauth_signature_method": "RSA-SHA1",
+		"oauth_timestamp":        strconv.FormatInt(time.Now().Unix(), 10),
+		"oauth_version":          "1.0",
+	}
+	if creds.token != "" {
+		params["oauth_token"] = creds.token
+	}
+
+	sig, err := rsaSHA1Signature(req.Method, baseURL(req), params, req.URL.Query(), creds.privateKey)
+	if err != nil {
+		return err
+	}
+	params["oauth_signature"] = sig
+
+	req.Header.Set("Authorization", buildOAuthHeader(params))
+	return nil
+}
+
+func baseURL(req *http.Request) string {
+	u := *req.URL
+	u.RawQuery = ""
+	u.Fragment = ""
+	return u.String()
+}
+
+// rsaSHA1Signature computes the RSA-SHA1 signature over the OAuth signature
+// base string, as described in RFC 5849 section 3.4.
+func rsaSHA1Signature(method, reqURL string, oauthParams map[string]string, query url.Values, key *rsa.PrivateKey) (string, error) {
+	all := url.Values{}
+	for k, v := range oauthParams {
+		all.Set(k, v)
+	}
+	for k, vs := range query {
+		for _, v := range vs {
+			all.Add(k, v)
+		}
+	}
+
+	base := strings.Join([]string{
+		strings.ToUpper(method),
+		percentEncode(reqURL),
+		percentEncode(normalizeParams(all)),
+	}, "&")
+
+	h := sha1.Sum([]byte(base))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA1, h[:])
+	if err != nil {
+		return "", fmt.Errorf("jira: failed to sign oauth1 request: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(sig), nil
+}
+
+// normalizeParams implements the "Parameter Normalization" step of RFC 5849
+// section 3.4.1.3.2: sort by key then value, percent-encode, join with '&'.
+func normalizeParams(values url.Values) string {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var pairs []string
+	for _, k := range keys {
+		vs := append([]string(nil), values[k]...)
+		sort.Strings(vs)
+		for _, v := range vs {
+			pairs = append(pairs, percentEncode(k)+"="+percentEncode(v))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// percentEncode applies RFC 3986 unreserved-character percent-encoding,
+// which is stricter than url.QueryEscape (it must not encode '-', '.', '_', '~'
+// but must encode everything else, including spaces as %20 rather than '+').
+func percentEncode(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+			c == '-' || c == '.' || c == '_' || c == '~' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func buildOAuthHeader(params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var parts []string
+	for _, k := range keys {
+		if !strings.HasPrefix(k, "oauth_") {
+			continue
+		}
+		parts = append(parts, fmt.Sprintf(`%s="%s"`, percentEncode(k), percentEncode(params[k])))
+	}
+	return "OAuth " + strings.Join(parts, ", ")
+}
+
+func oauthNonce() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// AuthorizeURL builds the URL the user must open in a browser to grant the
+// request token obtained from FetchRequestToken.
+func AuthorizeURL(serverURL, requestToken string) string {
+	return strings.TrimRight(serverURL, "/") +
+		"/plugins/servlet/oauth/authorize?oauth_token=" + url.QueryEscape(requestToken)
+}
+
+// FetchRequestToken performs step one of the three-legged handshake: POSTing
+// to /plugins/servlet/oauth/request-token, signed with the consumer key and
+// RSA private key, returning the unauthorized request token and its secret.
+func FetchRequestToken(serverURL, consumerKey string, key *OAuth1Key) (token, secret string, err error) {
+	creds := oauth1Credentials{consumerKey: consumerKey, privateKey: key.private}
+	return doOAuth1TokenRequest(serverURL+"/plugins/servlet/oauth/request-token", creds, "")
+}
+
+// FetchAccessToken performs step three of the handshake: exchanging the
+// authorized request token and the verifier the user pasted back for a
+// long-lived access token pair.
+func FetchAccessToken(serverURL, consumerKey string, key *OAuth1Key, requestToken, verifier string) (token, secret string, err error) {
+	creds := oauth1Credentials{consumerKey: consumerKey, privateKey: key.private, token: requestToken}
+	return doOAuth1TokenRequest(serverURL+"/plugins/servlet/oauth/access-token", creds, verifier)
+}
+
+func doOAuth1TokenRequest(endpoint string, creds oauth1Credentials, verifier string) (token, secret string, err error) {
+	req, err := http.NewRequest(http.MethodPost, endpoint, nil)
+	if err != nil {
+		return "", "", err
+	}
+	if verifier != "" {
+		q := req.URL.Query()
+		q.Set("oauth_verifier", verifier)
+		req.URL.RawQuery = q.Encode()
+	}
+	if err := signOAuth1Request(req, creds); err != nil {
+		return "", "", err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", "", err
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4<<10))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", "", fmt.Errorf("jira: %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return "", "", err
+	}
+	token = values.Get("oauth_token")
+	secret = values.Get("oauth_token_secret")
+	if token == "" || secret == "" {
+		return "", "", errors.New("jira: missing oauth_token/oauth_token_secret in response")
+	}
+	return token, secret, nil
+}
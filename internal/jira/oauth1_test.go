@@ -0,0 +1,55 @@
+package jira
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPercentEncode_RFC3986Unreserved(t *testing.T) {
+	assert.Equal(t, "abc-._~XYZ09", percentEncode("abc-._~XYZ09"))
+	assert.Equal(t, "a%20b%2Fc", percentEncode("a b/c"))
+}
+
+func TestNormalizeParams_SortsByKeyThenValue(t *testing.T) {
+	values := url.Values{}
+	values.Set("b", "2")
+	values.Add("a", "2")
+	values.Add("a", "1")
+	got := normalizeParams(values)
+	assert.Equal(t, "a=1&a=2&b=2", got)
+}
+
+func TestSignOAuth1Request_SetsAuthorizationHeader(t *testing.T) {
+	key, err := GenerateOAuth1Key()
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodGet, "https://jira.example.com/rest/api/2/myself?foo=bar", nil)
+	require.NoError(t, err)
+
+	err = signOAuth1Request(req, oauth1Credentials{
+		consumerKey: "consumer-123",
+		privateKey:  key.private,
+		token:       "access-token",
+	})
+	require.NoError(t, err)
+
+	auth := req.Header.Get("Authorization")
+	require.True(t, strings.HasPrefix(auth, "OAuth "))
+	assert.Contains(t, auth, `oauth_consumer_key="consumer-123"`)
+	assert.Contains(t, auth, `oauth_token="access-token"`)
+	assert.Contains(t, auth, `oauth_signature_method="RSA-SHA1"`)
+	assert.Contains(t, auth, "oauth_signature=")
+}
+
+func TestSignOAuth1Request_MissingCredentials(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://jira.example.com/rest/api/2/myself", nil)
+	require.NoError(t, err)
+
+	err = signOAuth1Request(req, oauth1Credentials{})
+	require.Error(t, err)
+}
@@ -0,0 +1,149 @@
+package jira
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Transition is a workflow transition available on an issue, as returned by
+// GetTransitions.
+type Transition struct {
+	ID   string
+	Name string
+}
+
+// GetTransitions lists the workflow transitions currently available for key.
+func (c Client) GetTransitions(key string) ([]Transition, error) {
+	if key == "" {
+		return nil, fmt.Errorf("jira: empty issue key")
+	}
+	endpoint := "/rest/api/2/issue/" + url.PathEscape(key) + "/transitions"
+	req, err := c.newRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 8<<10))
+		return nil, fmt.Errorf("jira: get transitions for %s failed: %s: %s", key, resp.Status, string(body))
+	}
+
+	var payload struct {
+		Transitions []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+			To   struct {
+				Name string `json:"name"`
+			} `json:"to"`
+		} `json:"transitions"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return nil, err
+	}
+
+	out := make([]Transition, 0, len(payload.Transitions))
+	for _, t := range payload.Transitions {
+		name := t.To.Name
+		if name == "" {
+			name = t.Name
+		}
+		out = append(out, Transition{ID: t.ID, Name: name})
+	}
+	return out, nil
+}
+
+// TransitionIssue moves key through the transition identified by
+// transitionID, as returned by GetTransitions.
+func (c Client) TransitionIssue(key, transitionID string) error {
+	if key == "" {
+		return fmt.Errorf("jira: empty issue key")
+	}
+	if transitionID == "" {
+		return fmt.Errorf("jira: empty transition id")
+	}
+	body, err := json.Marshal(map[string]any{
+		"transition": map[string]string{"id": transitionID},
+	})
+	if err != nil {
+		return err
+	}
+	endpoint := "/rest/api/2/issue/" + url.PathEscape(key) + "/transitions"
+	req, err := c.newRequest(http.MethodPost, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.do(req, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 8<<10))
+		return fmt.Errorf("jira: transition %s failed: %s: %s", key, resp.Status, string(respBody))
+	}
+	return nil
+}
+
+// FindTransitionByStatusName resolves transitionName to a transition ID on
+// key, matching case-insensitively against the transition's target status
+// name. It returns an error listing the allowed transitions when no match
+// is found.
+func (c Client) FindTransitionByStatusName(key, transitionName string) (string, error) {
+	transitions, err := c.GetTransitions(key)
+	if err != nil {
+		return "", err
+	}
+	for _, t := range transitions {
+		if strings.EqualFold(t.Name, transitionName) {
+			return t.ID, nil
+		}
+	}
+	names := make([]string, 0, len(transitions))
+	for _, t := range transitions {
+		names = append(names, t.Name)
+	}
+	return "", fmt.Errorf("jira: no transition named %q on %s, allowed transitions: %s", transitionName, key, strings.Join(names, ", "))
+}
+
+// AddComment posts body as a new comment on key.
+func (c Client) AddComment(key, body string) error {
+	if key == "" {
+		return fmt.Errorf("jira: empty issue key")
+	}
+	payload, err := json.Marshal(map[string]string{"body": body})
+	if err != nil {
+		return err
+	}
+	endpoint := "/rest/api/2/issue/" + url.PathEscape(key) + "/comment"
+	req, err := c.newRequest(http.MethodPost, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(payload))
+	req.ContentLength = int64(len(payload))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.do(req, payload)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 8<<10))
+		return fmt.Errorf("jira: add comment on %s failed: %s: %s", key, resp.Status, string(respBody))
+	}
+	return nil
+}
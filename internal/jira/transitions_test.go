@@ -0,0 +1,69 @@
+package jira
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTransitions(t *testing.T) {
+	client, ts := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/rest/api/2/issue/PROJ-1/transitions", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"transitions":[{"id":"11","name":"Start","to":{"name":"In Progress"}},{"id":"21","name":"Done","to":{"name":"Done"}}]}`))
+	})
+	defer ts.Close()
+
+	transitions, err := client.GetTransitions("PROJ-1")
+	require.NoError(t, err)
+	require.Len(t, transitions, 2)
+	assert.Equal(t, Transition{ID: "11", Name: "In Progress"}, transitions[0])
+}
+
+func TestFindTransitionByStatusName_CaseInsensitive(t *testing.T) {
+	client, ts := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"transitions":[{"id":"31","name":"Start Review","to":{"name":"In Review"}}]}`))
+	})
+	defer ts.Close()
+
+	id, err := client.FindTransitionByStatusName("PROJ-1", "in review")
+	require.NoError(t, err)
+	assert.Equal(t, "31", id)
+}
+
+func TestFindTransitionByStatusName_NoMatch(t *testing.T) {
+	client, ts := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"transitions":[{"id":"31","name":"Start Review","to":{"name":"In Review"}}]}`))
+	})
+	defer ts.Close()
+
+	_, err := client.FindTransitionByStatusName("PROJ-1", "Done")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "In Review")
+}
+
+func TestTransitionIssue_OK(t *testing.T) {
+	client, ts := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/rest/api/2/issue/PROJ-1/transitions", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer ts.Close()
+
+	require.NoError(t, client.TransitionIssue("PROJ-1", "11"))
+}
+
+func TestAddComment_OK(t *testing.T) {
+	client, ts := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/rest/api/2/issue/PROJ-1/comment", r.URL.Path)
+		w.WriteHeader(http.StatusCreated)
+	})
+	defer ts.Close()
+
+	require.NoError(t, client.AddComment("PROJ-1", "hello"))
+}
@@ -0,0 +1,322 @@
+package markdown
+
+import (
+	"strings"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	extast "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+// ADFDocument is the root of an Atlassian Document Format tree, the JSON
+// shape Jira Cloud's REST v3 API requires for issue/comment bodies.
+type ADFDocument struct {
+	Type    string    `json:"type"`
+	Version int       `json:"version"`
+	Content []ADFNode `json:"content"`
+}
+
+// ADFNode is a single block or inline node within an ADFDocument. Text
+// is only set on "text" nodes; Attrs and Content are omitted when the
+// node type has none (e.g. a "text" node has no Content, a "hardBreak"
+// has neither Attrs nor Content).
+type ADFNode struct {
+	Type    string         `json:"type"`
+	Text    string         `json:"text,omitempty"`
+	Attrs   map[string]any `json:"attrs,omitempty"`
+	Marks   []ADFMark      `json:"marks,omitempty"`
+	Content []ADFNode      `json:"content,omitempty"`
+}
+
+// ADFMark is an inline mark (strong, em, link, ...) applied to a "text"
+// ADFNode.
+type ADFMark struct {
+	Type  string         `json:"type"`
+	Attrs map[string]any `json:"attrs,omitempty"`
+}
+
+// RenderMarkdownToADF converts GitHub-flavored Markdown into the ADF tree
+// Jira Cloud expects for comment/description bodies. It parses the
+// Markdown into an AST with goldmark (GFM tables and strikethrough
+// enabled) and walks that into ADF nodes, rather than pattern-matching
+// the text directly: nested inline marks ("**_bold italic_**") need a
+// real mark stack that survives descending through multiple inline
+// nodes, which a sequence of regex substitutions can't express reliably.
+func RenderMarkdownToADF(source string) ADFDocument {
+	src := []byte(source)
+	md := goldmark.New(goldmark.WithExtensions(extension.GFM))
+	doc := md.Parser().Parse(text.NewReader(src))
+
+	r := &adfRenderer{source: src}
+	content := r.renderBlocks(doc.FirstChild())
+	if content == nil {
+		content = []ADFNode{}
+	}
+	return ADFDocument{Type: "doc", Version: 1, Content: content}
+}
+
+type adfRenderer struct {
+	source []byte
+}
+
+// renderBlocks walks block-level siblings starting at n, skipping node
+// kinds ADF has no equivalent for (e.g. a thematic break).
+func (r *adfRenderer) renderBlocks(n gast.Node) []ADFNode {
+	var out []ADFNode
+	for ; n != nil; n = n.NextSibling() {
+		if node, ok := r.renderBlock(n); ok {
+			out = append(out, node)
+		}
+	}
+	return out
+}
+
+func (r *adfRenderer) renderBlock(n gast.Node) (ADFNode, bool) {
+	switch n.Kind() {
+	case gast.KindParagraph, gast.KindTextBlock:
+		return ADFNode{Type: "paragraph", Content: r.renderInline(n.FirstChild())}, true
+	case gast.KindHeading:
+		h := n.(*gast.Heading)
+		return ADFNode{
+			Type:    "heading",
+			Attrs:   map[string]any{"level": h.Level},
+			Content: r.renderInline(n.FirstChild()),
+		}, true
+	case gast.KindList:
+		l := n.(*gast.List)
+		typ := "bulletList"
+		if l.IsOrdered() {
+			typ = "orderedList"
+		}
+		return ADFNode{Type: typ, Content: r.renderListItems(n)}, true
+	case gast.KindBlockquote:
+		return ADFNode{Type: "blockquote", Content: r.renderBlocks(n.FirstChild())}, true
+	case gast.KindFencedCodeBlock, gast.KindCodeBlock:
+		return r.renderCodeBlock(n), true
+	case extast.KindTable:
+		return ADFNode{Type: "table", Content: r.renderTableRows(n)}, true
+	default:
+		// ThematicBreak, HTMLBlock, and anything else ADF has no node
+		// for: drop it rather than fail the conversion.
+		return ADFNode{}, false
+	}
+}
+
+func (r *adfRenderer) renderListItems(list gast.Node) []ADFNode {
+	var items []ADFNode
+	for n := list.FirstChild(); n != nil; n = n.NextSibling() {
+		items = append(items, ADFNode{Type: "listItem", Content: r.renderBlocks(n.FirstChild())})
+	}
+	return items
+}
+
+func (r *adfRenderer) renderCodeBlock(n gast.Node) ADFNode {
+	var lang string
+	if fcb, ok := n.(*gast.FencedCodeBlock); ok {
+		lang = string(fcb.Language(r.source))
+	}
+
+	var body strings.Builder
+	lines := n.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		segment := lines.At(i)
+		body.Write(segment.Value(r.source))
+	}
+
+	node := ADFNode{Type: "codeBlock"}
+	if lang != "" {
+		node.Attrs = map[string]any{"language": lang}
+	}
+	if text := strings.TrimSuffix(body.String(), "\n"); text != "" {
+		node.Content = []ADFNode{{Type: "text", Text: text}}
+	}
+	return node
+}
+
+func (r *adfRenderer) renderTableRows(table gast.Node) []ADFNode {
+	var rows []ADFNode
+	for n := table.FirstChild(); n != nil; n = n.NextSibling() {
+		cellType := "tableCell"
+		if n.Kind() == extast.KindTableHeader {
+			cellType = "tableHeader"
+		}
+		var cells []ADFNode
+		for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+			cells = append(cells, ADFNode{
+				Type:    cellType,
+				Content: []ADFNode{{Type: "paragraph", Content: r.renderInline(c.FirstChild())}},
+			})
+		}
+		rows = append(rows, ADFNode{Type: "tableRow", Content: cells})
+	}
+	return rows
+}
+
+// renderInline walks the inline nodes starting at n into ADF "text"
+// nodes (and "hardBreak"s), with no marks active yet.
+func (r *adfRenderer) renderInline(n gast.Node) []ADFNode {
+	out := mergeAdjacentText(r.renderInlineMarked(n, nil, false))
+	if out == nil {
+		out = []ADFNode{}
+	}
+	return out
+}
+
+// mergeAdjacentText merges runs of consecutive "text" nodes that carry
+// the same marks into a single node. Goldmark's inline parser can split
+// a single run of plain text into multiple Text AST nodes (e.g. around
+// word boundaries it considers for later trigger characters), which
+// would otherwise surface as spurious extra "text" nodes in the ADF
+// output.
+func mergeAdjacentText(nodes []ADFNode) []ADFNode {
+	var out []ADFNode
+	for _, n := range nodes {
+		if n.Type == "text" && len(out) > 0 {
+			prev := &out[len(out)-1]
+			if prev.Type == "text" && marksEqual(prev.Marks, n.Marks) {
+				prev.Text += n.Text
+				continue
+			}
+		}
+		out = append(out, n)
+	}
+	return out
+}
+
+func marksEqual(a, b []ADFMark) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Type != b[i].Type {
+			return false
+		}
+		if len(a[i].Attrs) != len(b[i].Attrs) {
+			return false
+		}
+		for k, v := range a[i].Attrs {
+			if b[i].Attrs[k] != v {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// renderInlineMarked renders the sibling chain starting at n, applying
+// marks to every "text" node produced and, when underline is true,
+// additionally applying an "underline" mark — goldmark has no emphasis
+// node for Markdown's "<u>...</u>" convention, so it's tracked here by
+// recognizing the raw-HTML "<u>" / "</u>" node pair and recursing over
+// the nodes between them with underline=true.
+func (r *adfRenderer) renderInlineMarked(n gast.Node, marks []ADFMark, underline bool) []ADFNode {
+	var out []ADFNode
+	for n != nil {
+		if n.Kind() == gast.KindRawHTML {
+			if strings.EqualFold(rawHTML(n, r.source), "<u>") {
+				if closing := r.findClosingTag(n.NextSibling(), "</u>"); closing != nil {
+					out = append(out, r.renderInlineRange(n.NextSibling(), closing, marks, true)...)
+					n = closing.NextSibling()
+					continue
+				}
+			}
+			// An unmatched "<u>"/"</u>", or any other raw HTML tag: Jira
+			// wiki/ADF has nothing to map it onto, so it's dropped.
+			n = n.NextSibling()
+			continue
+		}
+		out = append(out, r.renderInlineNode(n, marks, underline)...)
+		n = n.NextSibling()
+	}
+	return out
+}
+
+// renderInlineRange renders the sibling chain starting at n, stopping
+// before (not including) stop.
+func (r *adfRenderer) renderInlineRange(n, stop gast.Node, marks []ADFMark, underline bool) []ADFNode {
+	var out []ADFNode
+	for n != nil && n != stop {
+		out = append(out, r.renderInlineNode(n, marks, underline)...)
+		n = n.NextSibling()
+	}
+	return out
+}
+
+func (r *adfRenderer) findClosingTag(n gast.Node, tag string) gast.Node {
+	for ; n != nil; n = n.NextSibling() {
+		if n.Kind() == gast.KindRawHTML && strings.EqualFold(rawHTML(n, r.source), tag) {
+			return n
+		}
+	}
+	return nil
+}
+
+func rawHTML(n gast.Node, source []byte) string {
+	return strings.TrimSpace(string(n.(*gast.RawHTML).Segments.Value(source)))
+}
+
+func (r *adfRenderer) renderInlineNode(n gast.Node, marks []ADFMark, underline bool) []ADFNode {
+	switch n.Kind() {
+	case gast.KindText:
+		t := n.(*gast.Text)
+		out := []ADFNode{r.textNode(string(t.Value(r.source)), marks, underline)}
+		if t.SoftLineBreak() || t.HardLineBreak() {
+			out = append(out, ADFNode{Type: "hardBreak"})
+		}
+		return out
+	case gast.KindString:
+		return []ADFNode{r.textNode(string(n.(*gast.String).Value), marks, underline)}
+	case gast.KindCodeSpan:
+		return []ADFNode{r.textNode(codeSpanText(n, r.source), withMark(marks, ADFMark{Type: "code"}), underline)}
+	case gast.KindEmphasis:
+		markType := "em"
+		if n.(*gast.Emphasis).Level >= 2 {
+			markType = "strong"
+		}
+		return r.renderInlineMarked(n.FirstChild(), withMark(marks, ADFMark{Type: markType}), underline)
+	case extast.KindStrikethrough:
+		return r.renderInlineMarked(n.FirstChild(), withMark(marks, ADFMark{Type: "strike"}), underline)
+	case gast.KindLink:
+		l := n.(*gast.Link)
+		link := ADFMark{Type: "link", Attrs: map[string]any{"href": string(l.Destination)}}
+		return r.renderInlineMarked(n.FirstChild(), withMark(marks, link), underline)
+	case gast.KindAutoLink:
+		a := n.(*gast.AutoLink)
+		url := string(a.URL(r.source))
+		link := ADFMark{Type: "link", Attrs: map[string]any{"href": url}}
+		return []ADFNode{r.textNode(url, withMark(marks, link), underline)}
+	default:
+		return r.renderInlineMarked(n.FirstChild(), marks, underline)
+	}
+}
+
+// withMark appends mark to a copy of marks, so sibling branches (e.g. the
+// text before and after a link within the same paragraph) don't share —
+// and corrupt — each other's mark stack via an aliased backing array.
+func withMark(marks []ADFMark, mark ADFMark) []ADFMark {
+	out := make([]ADFMark, len(marks), len(marks)+1)
+	copy(out, marks)
+	return append(out, mark)
+}
+
+func (r *adfRenderer) textNode(value string, marks []ADFMark, underline bool) ADFNode {
+	if underline {
+		marks = withMark(marks, ADFMark{Type: "underline"})
+	}
+	return ADFNode{Type: "text", Text: value, Marks: marks}
+}
+
+// codeSpanText concatenates the Text children of a CodeSpan node, since
+// a code span can be split across more than one Text segment (e.g. when
+// its content spans a soft line break).
+func codeSpanText(n gast.Node, source []byte) string {
+	var b strings.Builder
+	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+		if t, ok := c.(*gast.Text); ok {
+			b.Write(t.Value(source))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,150 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderMarkdownToADF_Paragraph(t *testing.T) {
+	doc := RenderMarkdownToADF("Hello world")
+	assert.Equal(t, "doc", doc.Type)
+	assert.Equal(t, 1, doc.Version)
+	assert.Equal(t, []ADFNode{
+		{Type: "paragraph", Content: []ADFNode{{Type: "text", Text: "Hello world"}}},
+	}, doc.Content)
+}
+
+func TestRenderMarkdownToADF_Heading(t *testing.T) {
+	doc := RenderMarkdownToADF("### Title")
+	assert.Equal(t, []ADFNode{
+		{
+			Type:    "heading",
+			Attrs:   map[string]any{"level": 3},
+			Content: []ADFNode{{Type: "text", Text: "Title"}},
+		},
+	}, doc.Content)
+}
+
+func TestRenderMarkdownToADF_InlineMarks(t *testing.T) {
+	doc := RenderMarkdownToADF("**bold** and *em* and ~~strike~~ and `code`")
+	want := []ADFNode{
+		{Type: "paragraph", Content: []ADFNode{
+			{Type: "text", Text: "bold", Marks: []ADFMark{{Type: "strong"}}},
+			{Type: "text", Text: " and "},
+			{Type: "text", Text: "em", Marks: []ADFMark{{Type: "em"}}},
+			{Type: "text", Text: " and "},
+			{Type: "text", Text: "strike", Marks: []ADFMark{{Type: "strike"}}},
+			{Type: "text", Text: " and "},
+			{Type: "text", Text: "code", Marks: []ADFMark{{Type: "code"}}},
+		}},
+	}
+	assert.Equal(t, want, doc.Content)
+}
+
+func TestRenderMarkdownToADF_NestedMarks(t *testing.T) {
+	doc := RenderMarkdownToADF("**_bold italic_**")
+	want := []ADFNode{
+		{Type: "paragraph", Content: []ADFNode{
+			{Type: "text", Text: "bold italic", Marks: []ADFMark{{Type: "strong"}, {Type: "em"}}},
+		}},
+	}
+	assert.Equal(t, want, doc.Content)
+}
+
+func TestRenderMarkdownToADF_Underline(t *testing.T) {
+	doc := RenderMarkdownToADF("plain <u>underlined</u> plain")
+	want := []ADFNode{
+		{Type: "paragraph", Content: []ADFNode{
+			{Type: "text", Text: "plain "},
+			{Type: "text", Text: "underlined", Marks: []ADFMark{{Type: "underline"}}},
+			{Type: "text", Text: " plain"},
+		}},
+	}
+	assert.Equal(t, want, doc.Content)
+}
+
+func TestRenderMarkdownToADF_Link(t *testing.T) {
+	doc := RenderMarkdownToADF("[docs](https://example.com/docs)")
+	want := []ADFNode{
+		{Type: "paragraph", Content: []ADFNode{
+			{Type: "text", Text: "docs", Marks: []ADFMark{
+				{Type: "link", Attrs: map[string]any{"href": "https://example.com/docs"}},
+			}},
+		}},
+	}
+	assert.Equal(t, want, doc.Content)
+}
+
+func TestRenderMarkdownToADF_BulletList(t *testing.T) {
+	doc := RenderMarkdownToADF("- one\n- two\n")
+	want := []ADFNode{
+		{Type: "bulletList", Content: []ADFNode{
+			{Type: "listItem", Content: []ADFNode{
+				{Type: "paragraph", Content: []ADFNode{{Type: "text", Text: "one"}}},
+			}},
+			{Type: "listItem", Content: []ADFNode{
+				{Type: "paragraph", Content: []ADFNode{{Type: "text", Text: "two"}}},
+			}},
+		}},
+	}
+	assert.Equal(t, want, doc.Content)
+}
+
+func TestRenderMarkdownToADF_OrderedList(t *testing.T) {
+	doc := RenderMarkdownToADF("1. one\n2. two\n")
+	assert.Equal(t, "orderedList", doc.Content[0].Type)
+}
+
+func TestRenderMarkdownToADF_CodeBlock(t *testing.T) {
+	doc := RenderMarkdownToADF("```go\nfmt.Println(\"hi\")\n```\n")
+	want := []ADFNode{
+		{
+			Type:    "codeBlock",
+			Attrs:   map[string]any{"language": "go"},
+			Content: []ADFNode{{Type: "text", Text: `fmt.Println("hi")`}},
+		},
+	}
+	assert.Equal(t, want, doc.Content)
+}
+
+func TestRenderMarkdownToADF_Blockquote(t *testing.T) {
+	doc := RenderMarkdownToADF("> quoted text\n")
+	want := []ADFNode{
+		{Type: "blockquote", Content: []ADFNode{
+			{Type: "paragraph", Content: []ADFNode{{Type: "text", Text: "quoted text"}}},
+		}},
+	}
+	assert.Equal(t, want, doc.Content)
+}
+
+func TestRenderMarkdownToADF_Table(t *testing.T) {
+	in := "| h1 | h2 |\n| --- | --- |\n| a | b |\n"
+	doc := RenderMarkdownToADF(in)
+	want := []ADFNode{
+		{Type: "table", Content: []ADFNode{
+			{Type: "tableRow", Content: []ADFNode{
+				{Type: "tableHeader", Content: []ADFNode{
+					{Type: "paragraph", Content: []ADFNode{{Type: "text", Text: "h1"}}},
+				}},
+				{Type: "tableHeader", Content: []ADFNode{
+					{Type: "paragraph", Content: []ADFNode{{Type: "text", Text: "h2"}}},
+				}},
+			}},
+			{Type: "tableRow", Content: []ADFNode{
+				{Type: "tableCell", Content: []ADFNode{
+					{Type: "paragraph", Content: []ADFNode{{Type: "text", Text: "a"}}},
+				}},
+				{Type: "tableCell", Content: []ADFNode{
+					{Type: "paragraph", Content: []ADFNode{{Type: "text", Text: "b"}}},
+				}},
+			}},
+		}},
+	}
+	assert.Equal(t, want, doc.Content)
+}
+
+func TestRenderMarkdownToADF_Empty(t *testing.T) {
+	doc := RenderMarkdownToADF("")
+	assert.Equal(t, []ADFNode{}, doc.Content)
+}
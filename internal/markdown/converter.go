@@ -1,6 +1,8 @@
 package markdown
 
 import (
+	"bufio"
+	"fmt"
 	"regexp"
 	"strings"
 )
@@ -11,10 +13,22 @@ import (
 // - Lists: * bullet lists, # ordered lists (with nesting)
 // - Inline styles: *bold*, _italic_, -strike-, +underline+, {{code}}
 // - Links: [text|url] and [url]
+// - Embedded images: !file.png|thumbnail! and !file.png|width=300!
+// - Attachment references: [^file.pdf]
 // - Code blocks: {code[:lang]} ... {code}
 // - Quote blocks: {quote} ... {quote}
 // - Tables: ||h1||h2|| and |c1|c2|
+//
+// It's a thin wrapper over NewConverter() for callers that don't need a
+// custom Renderer, code-fence language mapping, or issue-link resolver.
 func ConvertJiraToMarkdown(text string) string {
+	return NewConverter().ConvertJiraToMarkdown(text)
+}
+
+// ConvertJiraToMarkdown runs c's configured Renderer, code-fence language
+// mapping, and issue-link resolver over text. See the package-level
+// ConvertJiraToMarkdown for the supported Jira wiki markup subset.
+func (c *Converter) ConvertJiraToMarkdown(text string) string {
 	if text == "" {
 		return ""
 	}
@@ -24,21 +38,29 @@ func ConvertJiraToMarkdown(text string) string {
 	s = strings.ReplaceAll(s, "\r", "\n")
 
 	// Convert code blocks first to avoid interfering with inline replacements
-	s = convertCodeBlocks(s)
+	s = c.convertCodeBlocks(s)
 	// Convert quote blocks
 	s = convertQuoteBlocks(s)
 	// Convert lists before headings so resulting Markdown headings ("# ") are not mistaken for lists
 	s = convertLists(s)
 	// Convert headings
-	s = convertHeadings(s)
+	s = c.convertHeadings(s)
+	// Convert embedded images and attachment references before links, since
+	// "[^file.pdf]" would otherwise fall through convertLinks untouched
+	s = convertImagesAndAttachments(s)
 	// Convert links
 	s = convertLinks(s)
 	// Convert inline code
-	s = convertInlineCode(s)
+	s = c.convertInlineCode(s)
 	// Convert inline styles (bold, italics, strike, underline)
-	s = convertInlineStyles(s)
+	s = c.convertInlineStyles(s)
 	// Convert tables (line-oriented) after inline styles so table separators are not altered
 	s = convertTables(s)
+	// Resolve bare "[ISSUE-123]" issue-key references last: the Markdown
+	// link it produces has its own "-" and "_" characters (from the issue
+	// key and, usually, the server URL) that convertInlineStyles would
+	// otherwise misparse as strike/italic markers
+	s = c.resolveIssueLinks(s)
 
 	// Trim trailing whitespace on lines for neatness
 	lines := strings.Split(s, "\n")
@@ -48,7 +70,7 @@ func ConvertJiraToMarkdown(text string) string {
 	return strings.Join(lines, "\n")
 }
 
-func convertHeadings(s string) string {
+func (c *Converter) convertHeadings(s string) string {
 	// Jira headings: h1. Title -> # Title
 	re := regexp.MustCompile(`(?m)^h([1-6])\.\s*(.*)$`)
 	return re.ReplaceAllStringFunc(s, func(line string) string {
@@ -56,10 +78,8 @@ func convertHeadings(s string) string {
 		if len(m) != 3 {
 			return line
 		}
-		level := m[1]
-		title := m[2]
-		n := int(level[0] - '0')
-		return strings.Repeat("#", n) + " " + title
+		level := int(m[1][0] - '0')
+		return c.renderer.Heading(level, m[2])
 	})
 }
 
@@ -88,6 +108,20 @@ func convertLists(s string) string {
 	})
 }
 
+// convertImagesAndAttachments handles Jira's embedded-image syntax
+// ("!file.png!", "!file.png|thumbnail!", "!file.png|width=300!") and
+// attachment references ("[^file.pdf]"), emitting Markdown image/link
+// syntax that points at the attachment's filename. The filename alone
+// isn't a fetchable URL; jira.Issue.MaterializeAttachments rewrites it to
+// a real path (local or re-uploaded) once attachments are downloaded.
+func convertImagesAndAttachments(s string) string {
+	image := regexp.MustCompile(`!([^|!\s]+)(?:\|[^!]*)?!`)
+	s = image.ReplaceAllString(s, "![$1]($1)")
+	attachment := regexp.MustCompile(`\[\^([^\]]+)\]`)
+	s = attachment.ReplaceAllString(s, "[$1]($1)")
+	return s
+}
+
 func convertLinks(s string) string {
 	// [text|url] -> [text](url)
 	re1 := regexp.MustCompile(`\[([^\]|]+)\|([^\]]+)\]`)
@@ -98,29 +132,40 @@ func convertLinks(s string) string {
 	return s
 }
 
-func convertInlineCode(s string) string {
+func (c *Converter) convertInlineCode(s string) string {
 	// {{code}} -> `code`
 	re := regexp.MustCompile(`\{\{([^}]+)\}\}`)
-	return re.ReplaceAllString(s, "`$1`")
+	return re.ReplaceAllStringFunc(s, func(m string) string {
+		return c.renderer.InlineCode(re.FindStringSubmatch(m)[1])
+	})
 }
 
-func convertInlineStyles(s string) string {
+func (c *Converter) convertInlineStyles(s string) string {
 	// Bold: *bold* -> **bold** (avoid list line starts handled in convertLists)
 	bold := regexp.MustCompile(`(?m)(^|[^*])\*([^*\n]+)\*`)
-	s = bold.ReplaceAllString(s, "$1**$2**")
+	s = bold.ReplaceAllStringFunc(s, func(m string) string {
+		sub := bold.FindStringSubmatch(m)
+		return sub[1] + c.renderer.Bold(sub[2])
+	})
 	// Italic: _italic_ -> *italic*
 	italic := regexp.MustCompile(`_([^_\n]+)_`)
-	s = italic.ReplaceAllString(s, "*$1*")
+	s = italic.ReplaceAllStringFunc(s, func(m string) string {
+		return c.renderer.Italic(italic.FindStringSubmatch(m)[1])
+	})
 	// Strike: -strike- -> ~~strike~~
 	strike := regexp.MustCompile(`-([^\-\n]+)-`)
-	s = strike.ReplaceAllString(s, "~~$1~~")
+	s = strike.ReplaceAllStringFunc(s, func(m string) string {
+		return c.renderer.Strike(strike.FindStringSubmatch(m)[1])
+	})
 	// Underline: +text+ -> <u>text</u>
 	underline := regexp.MustCompile(`\+([^+\n]+)\+`)
-	s = underline.ReplaceAllString(s, "<u>$1</u>")
+	s = underline.ReplaceAllStringFunc(s, func(m string) string {
+		return c.renderer.Underline(underline.FindStringSubmatch(m)[1])
+	})
 	return s
 }
 
-func convertCodeBlocks(s string) string {
+func (c *Converter) convertCodeBlocks(s string) string {
 	// {code[:lang]}\n...\n{code}
 	re := regexp.MustCompile(`(?s)\{code(?::([^}\n]+))?\}\n?(.*?)\n?\{code\}`)
 	for {
@@ -129,13 +174,12 @@ func convertCodeBlocks(s string) string {
 			break
 		}
 		matches := re.FindStringSubmatch(s)
-		lang := matches[1]
-		body := matches[2]
-		fenced := "```"
-		if lang != "" {
-			fenced += strings.TrimSpace(lang)
+		lang := strings.TrimSpace(matches[1])
+		if mapped, ok := c.codeFenceLangs[lang]; ok {
+			lang = mapped
 		}
-		fenced += "\n" + strings.TrimRight(body, "\n") + "\n```"
+		body := strings.TrimRight(matches[2], "\n")
+		fenced := c.renderer.CodeFence(lang, body)
 		s = s[:loc[0]] + fenced + s[loc[1]:]
 	}
 	return s
@@ -162,26 +206,38 @@ func convertQuoteBlocks(s string) string {
 	return s
 }
 
+// convertTables makes a single bufio.Scanner pass over s, buffering only
+// the lines of a contiguous table block at a time rather than splitting
+// the whole document into a slice up front and re-walking it.
 func convertTables(s string) string {
-	lines := strings.Split(s, "\n")
+	scanner := bufio.NewScanner(strings.NewReader(s))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
 	var out []string
-	i := 0
-	for i < len(lines) {
-		line := lines[i]
-		trimmed := strings.TrimSpace(line)
-		if strings.HasPrefix(trimmed, "|") { // possible table block
-			// collect contiguous table lines
-			start := i
-			for i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), "|") {
-				i++
-			}
-			block := lines[start:i]
-			converted := convertTableBlock(block)
-			out = append(out, converted...)
+	var block []string
+	flushBlock := func() {
+		if block != nil {
+			out = append(out, convertTableBlock(block)...)
+			block = nil
+		}
+	}
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(strings.TrimSpace(line), "|") {
+			block = append(block, line)
 			continue
 		}
+		flushBlock()
 		out = append(out, line)
-		i++
+	}
+	flushBlock()
+
+	// bufio.Scanner's ScanLines, unlike strings.Split, doesn't emit a
+	// trailing empty line for a final "\n" — restore it so a trailing
+	// newline in s survives the round trip.
+	if strings.HasSuffix(s, "\n") {
+		out = append(out, "")
 	}
 	return strings.Join(out, "\n")
 }
@@ -222,6 +278,262 @@ func convertTableBlock(block []string) []string {
 	return out
 }
 
+// ConvertMarkdownToJira converts a subset of GitHub-flavored Markdown into
+// Jira wiki markup, the mirror image of ConvertJiraToMarkdown. Supported
+// features:
+//   - Headings: # .. ###### -> h1. .. h6.
+//   - Lists: -/*/+ bullets, N. ordered lists (with nesting), and
+//     "- [ ]"/"- [x]" task items (the checkbox text is kept as-is, since
+//     Jira wiki has no native checkbox macro)
+//   - Inline styles: **bold**, *italic*/_italic_, ~~strike~~, <u>..</u>
+//   - Links: [text](url), reference-style [text][ref] with a [ref]: url
+//     definition, and <https://...> autolinks
+//   - Inline code: `code`
+//   - Fenced code blocks: ```lang ... ``` -> {code:lang} ... {code}
+//   - Blockquotes: > ... -> {quote} ... {quote}
+//   - GFM tables, recognizing the `| --- |` separator row, into ||h||
+//     header rows
+//
+// Round-tripping (Jira -> Markdown -> Jira, or the reverse) is lossless for
+// the subset both converters share; see converter_test.go's round-trip
+// suite.
+func ConvertMarkdownToJira(text string) string {
+	if text == "" {
+		return ""
+	}
+
+	s := strings.ReplaceAll(text, "\r\n", "\n")
+	s = strings.ReplaceAll(s, "\r", "\n")
+
+	// Code blocks first, so their contents aren't mangled by the inline
+	// conversions below.
+	s = convertMarkdownCodeBlocks(s)
+	s = convertMarkdownQuoteBlocks(s)
+	// Lists (and task items) before headings/links, mirroring
+	// ConvertJiraToMarkdown's ordering.
+	s = convertMarkdownHeadings(s)
+	s = convertMarkdownLists(s)
+	s = convertMarkdownLinks(s)
+	s = convertMarkdownInlineCode(s)
+	s = convertMarkdownInlineStyles(s)
+	// Tables last, after inline styles, so cell content is already
+	// converted by the time rows are reassembled.
+	s = convertMarkdownTables(s)
+
+	lines := strings.Split(s, "\n")
+	for i := range lines {
+		lines[i] = strings.TrimRight(lines[i], " \t")
+	}
+	return strings.Join(lines, "\n")
+}
+
+func convertMarkdownHeadings(s string) string {
+	re := regexp.MustCompile(`(?m)^(#{1,6})\s+(.*)$`)
+	return re.ReplaceAllStringFunc(s, func(line string) string {
+		m := re.FindStringSubmatch(line)
+		return fmt.Sprintf("h%d. %s", len(m[1]), m[2])
+	})
+}
+
+// convertMarkdownLists converts bullet ("-", "*", "+"), ordered ("1.") and
+// task-list ("- [ ]"/"- [x]") items into Jira's "*"/"#" list markup,
+// nesting by the indentation width (two spaces per level, matching what
+// convertLists produces on the way out). The checkbox itself is kept
+// verbatim in the content, since Jira wiki markup has no native task-list
+// macro to map it onto.
+func convertMarkdownLists(s string) string {
+	re := regexp.MustCompile(`(?m)^([ \t]*)(?:([-*+])|\d+\.)\s+(?:(\[[ xX]\])\s+)?(.*)$`)
+	return re.ReplaceAllStringFunc(s, func(line string) string {
+		m := re.FindStringSubmatch(line)
+		indent, bullet, checkbox, content := m[1], m[2], m[3], m[4]
+		level := len(indent)/2 + 1
+		mark := "#"
+		if bullet != "" {
+			mark = "*"
+		}
+		if checkbox != "" {
+			content = checkbox + " " + content
+		}
+		return strings.Repeat(mark, level) + " " + content
+	})
+}
+
+// convertMarkdownLinks handles inline links, reference-style links (with
+// their [ref]: url definitions), and <url> autolinks.
+func convertMarkdownLinks(s string) string {
+	// Reference definitions: "[ref]: url" -> removed, recorded for lookup.
+	defRe := regexp.MustCompile(`(?m)^\[([^\]]+)\]:\s*(\S+)\s*$`)
+	refs := map[string]string{}
+	s = defRe.ReplaceAllStringFunc(s, func(m string) string {
+		sub := defRe.FindStringSubmatch(m)
+		refs[strings.ToLower(sub[1])] = sub[2]
+		return ""
+	})
+
+	// Reference-style usages: [text][ref], or the implicit [text][] form.
+	refUse := regexp.MustCompile(`\[([^\]]+)\]\[([^\]]*)\]`)
+	s = refUse.ReplaceAllStringFunc(s, func(m string) string {
+		sub := refUse.FindStringSubmatch(m)
+		text, key := sub[1], sub[2]
+		if key == "" {
+			key = text
+		}
+		url, ok := refs[strings.ToLower(key)]
+		if !ok {
+			return m
+		}
+		return "[" + text + "|" + url + "]"
+	})
+
+	// Inline links: [text](url) -> [text|url]
+	inline := regexp.MustCompile(`\[([^\]]+)\]\(([^)]+)\)`)
+	s = inline.ReplaceAllString(s, "[$1|$2]")
+
+	// Autolinks: <https://example.com> -> [https://example.com]
+	autolink := regexp.MustCompile(`<(https?://[^>\s]+)>`)
+	s = autolink.ReplaceAllString(s, "[$1]")
+
+	return s
+}
+
+func convertMarkdownInlineCode(s string) string {
+	re := regexp.MustCompile("`([^`\n]+)`")
+	return re.ReplaceAllString(s, "{{$1}}")
+}
+
+// convertMarkdownInlineStyles converts **bold**, *italic*/_italic_,
+// ~~strike~~ and <u>..</u> into Jira's *bold*, _italic_, -strike- and
+// +underline+ respectively.
+//
+// Bold is extracted to a placeholder before the italic passes run: Jira's
+// own bold syntax is a single star (same character Markdown uses for
+// italic), so converting **bold** to *bold* in place would make the very
+// next regex mistake those stars for italic markers and re-wrap them.
+func convertMarkdownInlineStyles(s string) string {
+	var bolds []string
+	bold := regexp.MustCompile(`\*\*([^*\n]+)\*\*`)
+	s = bold.ReplaceAllStringFunc(s, func(m string) string {
+		bolds = append(bolds, bold.FindStringSubmatch(m)[1])
+		return fmt.Sprintf("\x00BOLD%d\x00", len(bolds)-1)
+	})
+
+	strike := regexp.MustCompile(`~~([^~\n]+)~~`)
+	s = strike.ReplaceAllString(s, "-$1-")
+
+	underline := regexp.MustCompile(`(?s)<u>(.*?)</u>`)
+	s = underline.ReplaceAllString(s, "+$1+")
+
+	italicStar := regexp.MustCompile(`\*([^*\n]+)\*`)
+	s = italicStar.ReplaceAllString(s, "_${1}_")
+	italicUnderscore := regexp.MustCompile(`_([^_\n]+)_`)
+	s = italicUnderscore.ReplaceAllString(s, "_${1}_")
+
+	for i, content := range bolds {
+		s = strings.ReplaceAll(s, fmt.Sprintf("\x00BOLD%d\x00", i), "*"+content+"*")
+	}
+	return s
+}
+
+func convertMarkdownCodeBlocks(s string) string {
+	re := regexp.MustCompile("(?s)```([a-zA-Z0-9_+-]*)\n?(.*?)\n?```")
+	for {
+		loc := re.FindStringSubmatchIndex(s)
+		if loc == nil {
+			break
+		}
+		m := re.FindStringSubmatch(s)
+		lang, body := m[1], m[2]
+		open := "{code}"
+		if lang != "" {
+			open = "{code:" + lang + "}"
+		}
+		replaced := open + "\n" + strings.TrimRight(body, "\n") + "\n{code}"
+		s = s[:loc[0]] + replaced + s[loc[1]:]
+	}
+	return s
+}
+
+func convertMarkdownQuoteBlocks(s string) string {
+	lines := strings.Split(s, "\n")
+	var out []string
+	i := 0
+	for i < len(lines) {
+		if strings.HasPrefix(strings.TrimSpace(lines[i]), ">") {
+			start := i
+			for i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), ">") {
+				i++
+			}
+			out = append(out, "{quote}")
+			for _, ln := range lines[start:i] {
+				trimmed := strings.TrimPrefix(strings.TrimSpace(ln), ">")
+				trimmed = strings.TrimPrefix(trimmed, " ")
+				out = append(out, trimmed)
+			}
+			out = append(out, "{quote}")
+			continue
+		}
+		out = append(out, lines[i])
+		i++
+	}
+	return strings.Join(out, "\n")
+}
+
+func convertMarkdownTables(s string) string {
+	lines := strings.Split(s, "\n")
+	var out []string
+	i := 0
+	for i < len(lines) {
+		trimmed := strings.TrimSpace(lines[i])
+		if strings.HasPrefix(trimmed, "|") && i+1 < len(lines) && isMarkdownTableSeparator(lines[i+1]) {
+			headerLine := lines[i]
+			i += 2 // skip the header row and the "| --- |" separator row
+			var body []string
+			for i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), "|") {
+				body = append(body, lines[i])
+				i++
+			}
+			out = append(out, convertMarkdownTableBlock(headerLine, body)...)
+			continue
+		}
+		out = append(out, lines[i])
+		i++
+	}
+	return strings.Join(out, "\n")
+}
+
+func isMarkdownTableSeparator(line string) bool {
+	re := regexp.MustCompile(`^\|?\s*:?-{3,}:?\s*(\|\s*:?-{3,}:?\s*)*\|?$`)
+	return re.MatchString(strings.TrimSpace(line))
+}
+
+func convertMarkdownTableBlock(headerLine string, body []string) []string {
+	header := parseMarkdownTableRow(headerLine)
+	var out []string
+	if len(header) > 0 {
+		out = append(out, "|| "+strings.Join(header, " || ")+" ||")
+	}
+	for _, ln := range body {
+		if strings.TrimSpace(ln) == "" {
+			continue
+		}
+		out = append(out, "| "+strings.Join(parseMarkdownTableRow(ln), " | ")+" |")
+	}
+	return out
+}
+
+func parseMarkdownTableRow(line string) []string {
+	trimmed := strings.Trim(strings.TrimSpace(line), "| ")
+	if trimmed == "" {
+		return nil
+	}
+	parts := strings.Split(trimmed, "|")
+	cells := make([]string, 0, len(parts))
+	for _, p := range parts {
+		cells = append(cells, strings.Join(strings.Fields(strings.TrimSpace(p)), " "))
+	}
+	return cells
+}
+
 func parseJiraTableRow(line string, header bool) []string {
 	// Normalize and trim pipes
 	trimmed := strings.TrimSpace(line)
@@ -54,6 +54,25 @@ func TestConvertJiraToMarkdown_InlineStylesAndLinks(t *testing.T) {
 	assert.Equalf(t, want, got, "inline styles/links mismatch")
 }
 
+func TestConvertJiraToMarkdown_ImagesAndAttachments(t *testing.T) {
+	in := strings.Join([]string{
+		"Embedded: !screenshot.png!",
+		"Thumbnail: !diagram.png|thumbnail!",
+		"Sized: !photo.jpg|width=300!",
+		"Attachment: [^notes.pdf]",
+		"",
+	}, "\n")
+	want := strings.Join([]string{
+		"Embedded: ![screenshot.png](screenshot.png)",
+		"Thumbnail: ![diagram.png](diagram.png)",
+		"Sized: ![photo.jpg](photo.jpg)",
+		"Attachment: [notes.pdf](notes.pdf)",
+		"",
+	}, "\n")
+	got := ConvertJiraToMarkdown(in)
+	assert.Equalf(t, want, got, "image/attachment conversion mismatch")
+}
+
 func TestConvertJiraToMarkdown_CodeBlocks(t *testing.T) {
 	in := strings.Join([]string{
 		"Before",
@@ -116,3 +135,164 @@ func TestConvertJiraToMarkdown_NewlineNormalization(t *testing.T) {
 	got := ConvertJiraToMarkdown(in)
 	assert.Equalf(t, want, got, "newlines mismatch")
 }
+
+func TestConvertMarkdownToJira_Headings(t *testing.T) {
+	in := "# Title\nMore\n### Small\n"
+	want := "h1. Title\nMore\nh3. Small\n"
+	got := ConvertMarkdownToJira(in)
+	assert.Equalf(t, want, got, "headings mismatch")
+}
+
+func TestConvertMarkdownToJira_Lists(t *testing.T) {
+	in := strings.Join([]string{
+		"- Top bullet",
+		"  - Nested bullet",
+		"1. Top ordered",
+		"  1. Nested ordered",
+		"",
+	}, "\n")
+	want := strings.Join([]string{
+		"* Top bullet",
+		"** Nested bullet",
+		"# Top ordered",
+		"## Nested ordered",
+		"",
+	}, "\n")
+	got := ConvertMarkdownToJira(in)
+	assert.Equalf(t, want, got, "lists mismatch")
+}
+
+func TestConvertMarkdownToJira_TaskList(t *testing.T) {
+	in := strings.Join([]string{
+		"- [ ] Todo",
+		"- [x] Done",
+		"",
+	}, "\n")
+	want := strings.Join([]string{
+		"* [ ] Todo",
+		"* [x] Done",
+		"",
+	}, "\n")
+	got := ConvertMarkdownToJira(in)
+	assert.Equalf(t, want, got, "task list mismatch")
+}
+
+func TestConvertMarkdownToJira_InlineStyles(t *testing.T) {
+	in := "This is **bold** and *italic* and _also italic_ and ~~strike~~ and <u>under</u>.\n"
+	want := "This is *bold* and _italic_ and _also italic_ and -strike- and +under+.\n"
+	got := ConvertMarkdownToJira(in)
+	assert.Equalf(t, want, got, "inline styles mismatch")
+}
+
+func TestConvertMarkdownToJira_InlineCode(t *testing.T) {
+	in := "Code inline: `x := 1`.\n"
+	want := "Code inline: {{x := 1}}.\n"
+	got := ConvertMarkdownToJira(in)
+	assert.Equalf(t, want, got, "inline code mismatch")
+}
+
+func TestConvertMarkdownToJira_Links(t *testing.T) {
+	in := strings.Join([]string{
+		"Inline: [Example](https://example.com)",
+		"Reference: [Example][ex]",
+		"Autolink: <https://example.org>",
+		"",
+		"[ex]: https://example.net",
+	}, "\n")
+	want := strings.Join([]string{
+		"Inline: [Example|https://example.com]",
+		"Reference: [Example|https://example.net]",
+		"Autolink: [https://example.org]",
+		"",
+		"",
+	}, "\n")
+	got := ConvertMarkdownToJira(in)
+	assert.Equalf(t, want, got, "links mismatch")
+}
+
+func TestConvertMarkdownToJira_CodeBlocks(t *testing.T) {
+	in := strings.Join([]string{
+		"Before",
+		"```go",
+		"fmt.Println(\"hi\")",
+		"```",
+		"After",
+		"",
+	}, "\n")
+	want := strings.Join([]string{
+		"Before",
+		"{code:go}",
+		"fmt.Println(\"hi\")",
+		"{code}",
+		"After",
+		"",
+	}, "\n")
+	got := ConvertMarkdownToJira(in)
+	assert.Equalf(t, want, got, "code blocks mismatch")
+}
+
+func TestConvertMarkdownToJira_QuoteBlocks(t *testing.T) {
+	in := strings.Join([]string{
+		"> Line 1",
+		"> Line 2",
+		"",
+	}, "\n")
+	want := strings.Join([]string{
+		"{quote}",
+		"Line 1",
+		"Line 2",
+		"{quote}",
+		"",
+	}, "\n")
+	got := ConvertMarkdownToJira(in)
+	assert.Equalf(t, want, got, "quote blocks mismatch")
+}
+
+func TestConvertMarkdownToJira_Tables_HeaderAndBody(t *testing.T) {
+	in := strings.Join([]string{
+		"| H1 | H2 |",
+		"| --- | --- |",
+		"| c1 | c2 |",
+		"| c3 | c4 |",
+		"",
+	}, "\n")
+	want := strings.Join([]string{
+		"|| H1 || H2 ||",
+		"| c1 | c2 |",
+		"| c3 | c4 |",
+		"",
+	}, "\n")
+	got := ConvertMarkdownToJira(in)
+	assert.Equalf(t, want, got, "tables mismatch")
+}
+
+func TestRoundTrip_JiraToMarkdownToJira(t *testing.T) {
+	corpus := []string{
+		"h1. Title\nSome text\nh3. Subsection\n",
+		"* Top bullet\n** Nested bullet\n# Top ordered\n## Nested ordered\n",
+		"This is *bold* and _italic_ and -strike- and +under+.\n",
+		"{code:go}\nfmt.Println(\"hi\")\n{code}\n",
+		"{quote}\nLine 1\nLine 2\n{quote}\n",
+		"|| H1 || H2 ||\n| c1 | c2 |\n",
+	}
+	for _, jira := range corpus {
+		md := ConvertJiraToMarkdown(jira)
+		back := ConvertMarkdownToJira(md)
+		assert.Equalf(t, jira, back, "round trip mismatch for %q (markdown: %q)", jira, md)
+	}
+}
+
+func TestRoundTrip_MarkdownToJiraToMarkdown(t *testing.T) {
+	corpus := []string{
+		"# Title\nSome text\n### Subsection\n",
+		"- Top bullet\n  - Nested bullet\n1. Top ordered\n  1. Nested ordered\n",
+		"This is **bold** and *italic* and ~~strike~~ and <u>under</u>.\n",
+		"```go\nfmt.Println(\"hi\")\n```\n",
+		"> Line 1\n> Line 2\n",
+	}
+	for _, md := range corpus {
+		jira := ConvertMarkdownToJira(md)
+		back := ConvertJiraToMarkdown(jira)
+		assert.Equalf(t, md, back, "round trip mismatch for %q (jira: %q)", md, jira)
+	}
+}
@@ -0,0 +1,88 @@
+package markdown
+
+import (
+	"regexp"
+	"strings"
+
+	appconfig "git-autometa/internal/config"
+)
+
+// Converter turns Jira wiki markup into Markdown (or vice versa via
+// ConvertMarkdownToJira) according to a configurable Renderer, code-fence
+// language mapping, and issue-link resolver. The zero value is not
+// usable; construct one with NewConverter.
+type Converter struct {
+	renderer       Renderer
+	codeFenceLangs map[string]string
+	resolveIssue   func(key string) string
+}
+
+// Option configures a Converter built by NewConverter.
+type Option func(*Converter)
+
+// WithRenderer selects the Renderer used for inline and block markup.
+// The default is GFMRenderer.
+func WithRenderer(r Renderer) Option {
+	return func(c *Converter) { c.renderer = r }
+}
+
+// WithCodeFenceLanguage maps a Jira "{code:jiraLang}" language tag onto
+// the language written into the Markdown fence, e.g.
+// WithCodeFenceLanguage("js", "javascript").
+func WithCodeFenceLanguage(jiraLang, mdLang string) Option {
+	return func(c *Converter) {
+		if c.codeFenceLangs == nil {
+			c.codeFenceLangs = map[string]string{}
+		}
+		c.codeFenceLangs[jiraLang] = mdLang
+	}
+}
+
+// WithIssueLinkResolver resolves a bare "[ISSUE-123]" Jira issue
+// reference into its replacement Markdown. resolve is called with just
+// the issue key ("ISSUE-123"); a nil resolver (the default) leaves
+// "[ISSUE-123]" references untouched.
+func WithIssueLinkResolver(resolve func(key string) string) Option {
+	return func(c *Converter) { c.resolveIssue = resolve }
+}
+
+// WithJiraServerURL is a convenience over WithIssueLinkResolver that
+// resolves "[ISSUE-123]" into a Markdown link at serverURL's
+// "/browse/ISSUE-123", the same path Jira's own web UI uses.
+func WithJiraServerURL(serverURL string) Option {
+	base := strings.TrimRight(serverURL, "/")
+	return WithIssueLinkResolver(func(key string) string {
+		return "[" + key + "](" + base + "/browse/" + key + ")"
+	})
+}
+
+// WithJiraConfig is WithJiraServerURL sourced from an appconfig.JiraConfig,
+// for callers that already have one loaded.
+func WithJiraConfig(cfg appconfig.JiraConfig) Option {
+	return WithJiraServerURL(cfg.ServerURL)
+}
+
+// NewConverter builds a Converter with GFMRenderer and no code-fence
+// mapping or issue-link resolution, then applies opts.
+func NewConverter(opts ...Option) *Converter {
+	c := &Converter{renderer: GFMRenderer{}}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// issueKeyRefRe matches a bare Jira issue key reference like
+// "[SMART-123]": a project key of upper-case letters/digits starting
+// with a letter, a dash, and a numeric id.
+var issueKeyRefRe = regexp.MustCompile(`\[([A-Z][A-Z0-9]*-\d+)\]`)
+
+func (c *Converter) resolveIssueLinks(s string) string {
+	if c.resolveIssue == nil {
+		return s
+	}
+	return issueKeyRefRe.ReplaceAllStringFunc(s, func(m string) string {
+		key := issueKeyRefRe.FindStringSubmatch(m)[1]
+		return c.resolveIssue(key)
+	})
+}
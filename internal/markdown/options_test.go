@@ -0,0 +1,50 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	appconfig "git-autometa/internal/config"
+)
+
+func TestConverter_PlainTextRenderer(t *testing.T) {
+	c := NewConverter(WithRenderer(PlainTextRenderer{}))
+	in := "h1. Title\n{code:go}\nfmt.Println(\"hi\")\n{code}\nThis is *bold* and _italic_ and -strike- and +under+ and {{code}}.\n"
+	want := "Title\nfmt.Println(\"hi\")\nThis is bold and italic and strike and under and code.\n"
+	got := c.ConvertJiraToMarkdown(in)
+	assert.Equalf(t, want, got, "plain-text rendering mismatch")
+}
+
+func TestConverter_CommonMarkRenderer_Underline(t *testing.T) {
+	c := NewConverter(WithRenderer(CommonMarkRenderer{}))
+	got := c.ConvertJiraToMarkdown("+ab+\n")
+	assert.Equalf(t, "a&#818;b&#818;\n", got, "commonmark underline mismatch")
+	assert.NotContainsf(t, got, "<u>", "commonmark renderer must not emit raw <u> tags")
+}
+
+func TestConverter_CodeFenceLanguageMapping(t *testing.T) {
+	c := NewConverter(WithCodeFenceLanguage("js", "javascript"))
+	got := c.ConvertJiraToMarkdown("{code:js}\nconsole.log(1)\n{code}\n")
+	assert.Equalf(t, "```javascript\nconsole.log(1)\n```\n", got, "code fence language mismatch")
+}
+
+func TestConverter_IssueLinkResolver(t *testing.T) {
+	c := NewConverter(WithJiraServerURL("https://jira.example.com/"))
+	got := c.ConvertJiraToMarkdown("See [SMART-123] for details.\n")
+	want := "See [SMART-123](https://jira.example.com/browse/SMART-123) for details.\n"
+	assert.Equalf(t, want, got, "issue link resolution mismatch")
+}
+
+func TestConverter_IssueLinkResolver_Unset(t *testing.T) {
+	c := NewConverter()
+	got := c.ConvertJiraToMarkdown("See [SMART-123] for details.\n")
+	assert.Equalf(t, "See [SMART-123] for details.\n", got, "unresolved issue keys should be left untouched")
+}
+
+func TestConverter_WithJiraConfig(t *testing.T) {
+	cfg := appconfig.JiraConfig{ServerURL: "https://jira.example.com"}
+	c := NewConverter(WithJiraConfig(cfg))
+	got := c.ConvertJiraToMarkdown("[SMART-1]\n")
+	assert.Equalf(t, "[SMART-1](https://jira.example.com/browse/SMART-1)\n", got, "WithJiraConfig mismatch")
+}
@@ -0,0 +1,83 @@
+package markdown
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Renderer produces the inline and block markup ConvertJiraToMarkdown
+// emits once a piece of Jira wiki syntax has been recognized. Swapping
+// the Renderer only changes how a recognized construct is written out;
+// GFMRenderer, CommonMarkRenderer, and PlainTextRenderer all see the
+// same parsed content.
+type Renderer interface {
+	Bold(text string) string
+	Italic(text string) string
+	Strike(text string) string
+	Underline(text string) string
+	InlineCode(text string) string
+	Heading(level int, text string) string
+	CodeFence(lang, body string) string
+}
+
+// GFMRenderer renders GitHub-flavored Markdown. It is
+// ConvertJiraToMarkdown's original and default output.
+type GFMRenderer struct{}
+
+func (GFMRenderer) Bold(text string) string       { return "**" + text + "**" }
+func (GFMRenderer) Italic(text string) string     { return "*" + text + "*" }
+func (GFMRenderer) Strike(text string) string     { return "~~" + text + "~~" }
+func (GFMRenderer) Underline(text string) string  { return "<u>" + text + "</u>" }
+func (GFMRenderer) InlineCode(text string) string { return "`" + text + "`" }
+
+func (GFMRenderer) Heading(level int, text string) string {
+	return fmt.Sprintf("%s %s", strings.Repeat("#", level), text)
+}
+
+func (GFMRenderer) CodeFence(lang, body string) string {
+	return "```" + lang + "\n" + body + "\n```"
+}
+
+// CommonMarkRenderer renders the same constructs as GFMRenderer except
+// underline: strict CommonMark renderers are free to escape or drop bare
+// inline HTML, so a raw "<u>" tag isn't reliable. Instead each rune of
+// the underlined span gets a trailing combining low line (U+0332) HTML
+// entity, a common HTML-free way to fake underline in plain text.
+type CommonMarkRenderer struct{}
+
+func (CommonMarkRenderer) Bold(text string) string       { return GFMRenderer{}.Bold(text) }
+func (CommonMarkRenderer) Italic(text string) string     { return GFMRenderer{}.Italic(text) }
+func (CommonMarkRenderer) Strike(text string) string     { return GFMRenderer{}.Strike(text) }
+func (CommonMarkRenderer) InlineCode(text string) string { return GFMRenderer{}.InlineCode(text) }
+
+func (CommonMarkRenderer) Heading(level int, text string) string {
+	return GFMRenderer{}.Heading(level, text)
+}
+
+func (CommonMarkRenderer) CodeFence(lang, body string) string {
+	return GFMRenderer{}.CodeFence(lang, body)
+}
+
+func (CommonMarkRenderer) Underline(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		b.WriteRune(r)
+		if r != ' ' {
+			b.WriteString("&#818;")
+		}
+	}
+	return b.String()
+}
+
+// PlainTextRenderer strips all formatting, returning just the
+// recognized text. It's meant for building commit-message summaries out
+// of Jira descriptions/comments, where markup characters are noise.
+type PlainTextRenderer struct{}
+
+func (PlainTextRenderer) Bold(text string) string               { return text }
+func (PlainTextRenderer) Italic(text string) string             { return text }
+func (PlainTextRenderer) Strike(text string) string             { return text }
+func (PlainTextRenderer) Underline(text string) string          { return text }
+func (PlainTextRenderer) InlineCode(text string) string         { return text }
+func (PlainTextRenderer) Heading(level int, text string) string { return text }
+func (PlainTextRenderer) CodeFence(lang, body string) string    { return body }
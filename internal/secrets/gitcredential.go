@@ -0,0 +1,74 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// GitCredentialProvider resolves secrets via `git credential fill`,
+// letting git-autometa reuse whatever credential helper (osxkeychain,
+// libsecret, Git Credential Manager, a custom store) the user already
+// has git configured with, rather than maintaining its own OS-specific
+// keyring integration on top of the shared zalando/go-keyring one.
+type GitCredentialProvider struct {
+	Host string
+
+	// run executes `git credential <action>` with input written to its
+	// stdin and returns its stdout, overridable in tests to avoid
+	// depending on a real credential helper being configured.
+	run func(action, input string) (string, error)
+}
+
+func (p GitCredentialProvider) Get(_, account string) (string, error) {
+	if p.Host == "" {
+		return "", nil
+	}
+	input := fmt.Sprintf("protocol=https\nhost=%s\nusername=%s\n\n", p.Host, account)
+	out, err := p.runAction("fill", input)
+	if err != nil {
+		// No configured helper, or the helper declined: treat as a miss
+		// like netrc/env, not a hard error, so the chain keeps going.
+		return "", nil
+	}
+	for _, line := range strings.Split(out, "\n") {
+		if strings.HasPrefix(line, "password=") {
+			return strings.TrimPrefix(line, "password="), nil
+		}
+	}
+	return "", nil
+}
+
+func (p GitCredentialProvider) Set(_, account, value string) error {
+	if p.Host == "" {
+		return fmt.Errorf("secrets: git-credential provider has no host configured")
+	}
+	input := fmt.Sprintf("protocol=https\nhost=%s\nusername=%s\npassword=%s\n\n", p.Host, account, value)
+	_, err := p.runAction("approve", input)
+	return err
+}
+
+func (p GitCredentialProvider) runAction(action, input string) (string, error) {
+	run := p.run
+	if run == nil {
+		run = runGitCredential
+	}
+	return run(action, input)
+}
+
+func runGitCredential(action, input string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, "git", "credential", action)
+	cmd.Stdin = strings.NewReader(input)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("secrets: git credential %s failed: %v: %s", action, err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.String(), nil
+}
@@ -0,0 +1,55 @@
+package secrets
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/jdx/go-netrc"
+)
+
+// NetrcProvider resolves secrets from ~/.netrc, matched by Host, falling
+// back to a "default" machine entry when no host-specific one exists
+// (the same fallback curl/ftp honor). A missing file, missing machine,
+// or parse error are all treated as a miss rather than an error: netrc
+// lookup is opportunistic, never required.
+type NetrcProvider struct {
+	Host string
+}
+
+func (p NetrcProvider) Get(_, _ string) (string, error) {
+	m := NetrcEntry(p.Host)
+	if m == nil {
+		return "", nil
+	}
+	return m.Get("password"), nil
+}
+
+// NetrcEntry looks up the ~/.netrc entry for host, falling back to a
+// "default" machine entry when no host-specific one exists (the same
+// fallback curl/ftp honor). Returns nil if the file is missing,
+// unparsable, or has no matching entry at all — netrc lookup is
+// opportunistic, never required. Shared by NetrcProvider and
+// auth.ResolveToken so the module has exactly one netrc-parsing/fallback
+// implementation instead of two independently-evolving copies.
+func NetrcEntry(host string) *netrc.Machine {
+	if host == "" {
+		return nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil
+	}
+	n, err := netrc.Parse(filepath.Join(home, ".netrc"))
+	if err != nil {
+		return nil
+	}
+	if m := n.Machine(host); m != nil {
+		return m
+	}
+	return n.Machine("default")
+}
+
+func (p NetrcProvider) Set(_, _, _ string) error {
+	return fmt.Errorf("secrets: netrc provider is read-only; edit ~/.netrc directly instead")
+}
@@ -0,0 +1,125 @@
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	keyring "github.com/zalando/go-keyring"
+)
+
+// Provider resolves a secret value for a (service, account) pair, e.g.
+// ("jira", "alice@example.com"). Implementations range from the OS
+// keyring to git's own credential helpers, ~/.netrc, and plain
+// environment variables; ChainProvider combines them into the module's
+// standard lookup order.
+type Provider interface {
+	Get(service, account string) (string, error)
+	Set(service, account, value string) error
+}
+
+// Credential sources usable with --credential-source / CredentialSource,
+// naming the Provider implementations ChainProvider tries in order.
+const (
+	SourceKeyring       = "keyring"
+	SourceGitCredential = "git-credential"
+	SourceNetrc         = "netrc"
+	SourceEnv           = "env"
+)
+
+// ChainProvider tries each Provider in order and returns the first
+// non-empty value, so a missing keyring entry (or no keyring at all, as
+// in a headless CI container) falls through to the next source instead
+// of failing outright.
+type ChainProvider struct {
+	Providers []Provider
+}
+
+// Get returns the first non-empty value any Provider in the chain
+// resolves for (service, account). A Provider erroring or returning ""
+// is treated as a miss and the chain moves on to the next one.
+func (c ChainProvider) Get(service, account string) (string, error) {
+	for _, p := range c.Providers {
+		value, err := p.Get(service, account)
+		if err == nil && value != "" {
+			return value, nil
+		}
+	}
+	return "", fmt.Errorf("secrets: no credential provider resolved a value for %s/%s", service, account)
+}
+
+// Set always writes through the chain's first provider (the OS keyring
+// in the standard chain), so tokens saved by the CLI land somewhere
+// durable regardless of what else is configured further down the chain.
+func (c ChainProvider) Set(service, account, value string) error {
+	if len(c.Providers) == 0 {
+		return errors.New("secrets: no credential providers configured")
+	}
+	return c.Providers[0].Set(service, account, value)
+}
+
+// jiraChain builds the standard keyring -> git-credential -> netrc -> env
+// provider chain for a Jira host, or a single forced Provider when
+// source is non-empty (one of the Source* constants).
+func jiraChain(host, source string) (Provider, error) {
+	providers := map[string]Provider{
+		SourceKeyring:       KeyringProvider{},
+		SourceGitCredential: GitCredentialProvider{Host: host},
+		SourceNetrc:         NetrcProvider{Host: host},
+		SourceEnv:           EnvProvider{},
+	}
+	if source == "" {
+		return ChainProvider{Providers: []Provider{
+			providers[SourceKeyring],
+			providers[SourceGitCredential],
+			providers[SourceNetrc],
+			providers[SourceEnv],
+		}}, nil
+	}
+	p, ok := providers[source]
+	if !ok {
+		return nil, fmt.Errorf("secrets: unknown credential source %q (want one of %s, %s, %s, %s)",
+			source, SourceKeyring, SourceGitCredential, SourceNetrc, SourceEnv)
+	}
+	return p, nil
+}
+
+// KeyringProvider resolves secrets from the consolidated OS keyring
+// service, namespaced the same way the legacy Get/SetJiraToken helpers
+// always have: accountKey(service, account).
+type KeyringProvider struct{}
+
+func (KeyringProvider) Get(service, account string) (string, error) {
+	value, err := keyring.Get(serviceName, accountKey(service, account))
+	if err != nil {
+		if errors.Is(err, keyring.ErrNotFound) {
+			return "", nil
+		}
+		return "", fmt.Errorf("secrets: keyring lookup failed: %w", err)
+	}
+	return value, nil
+}
+
+func (KeyringProvider) Set(service, account, value string) error {
+	return keyring.Set(serviceName, accountKey(service, account), value)
+}
+
+// EnvProvider resolves secrets from environment variables named
+// "GIT_AUTOMETA_<SERVICE>_TOKEN" (service upper-cased), e.g. service
+// "jira" reads $GIT_AUTOMETA_JIRA_TOKEN. It is the last-resort source in
+// the standard chain, letting CI/containers inject a token without
+// touching the keyring, a credential helper, or ~/.netrc.
+type EnvProvider struct{}
+
+func (EnvProvider) Get(service, _ string) (string, error) {
+	return strings.TrimSpace(os.Getenv(envVarName(service))), nil
+}
+
+func (EnvProvider) Set(service, _, _ string) error {
+	return fmt.Errorf("secrets: env provider is read-only; set %s directly instead", envVarName(service))
+}
+
+func envVarName(service string) string {
+	return "GIT_AUTOMETA_" + strings.ToUpper(service) + "_TOKEN"
+}
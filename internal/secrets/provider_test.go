@@ -0,0 +1,196 @@
+package secrets
+
+import (
+	"errors"
+	"testing"
+)
+
+// fakeProvider is a minimal in-memory Provider, standing in for the
+// keyring/git-credential/netrc/env backends in ChainProvider tests.
+type fakeProvider struct {
+	values  map[string]string
+	getErr  error
+	setErr  error
+	gotGets []string
+}
+
+func (f *fakeProvider) Get(service, account string) (string, error) {
+	f.gotGets = append(f.gotGets, service+"/"+account)
+	if f.getErr != nil {
+		return "", f.getErr
+	}
+	return f.values[service+"/"+account], nil
+}
+
+func (f *fakeProvider) Set(service, account, value string) error {
+	if f.setErr != nil {
+		return f.setErr
+	}
+	if f.values == nil {
+		f.values = map[string]string{}
+	}
+	f.values[service+"/"+account] = value
+	return nil
+}
+
+func TestChainProvider_FirstNonEmptyWins(t *testing.T) {
+	keyring := &fakeProvider{values: map[string]string{}}
+	gitCred := &fakeProvider{values: map[string]string{"jira/alice": "from-git-credential"}}
+	netrc := &fakeProvider{values: map[string]string{"jira/alice": "from-netrc"}}
+	env := &fakeProvider{values: map[string]string{"jira/alice": "from-env"}}
+	chain := ChainProvider{Providers: []Provider{keyring, gitCred, netrc, env}}
+
+	got, err := chain.Get("jira", "alice")
+	if err != nil {
+		t.Fatalf("Get error: %v", err)
+	}
+	if got != "from-git-credential" {
+		t.Fatalf("expected the first non-empty provider to win, got %q", got)
+	}
+	if len(netrc.gotGets) != 0 || len(env.gotGets) != 0 {
+		t.Fatalf("expected providers after the first hit to be skipped")
+	}
+}
+
+func TestChainProvider_SkipsErroringProvider(t *testing.T) {
+	keyring := &fakeProvider{getErr: errors.New("no dbus session")}
+	env := &fakeProvider{values: map[string]string{"jira/alice": "from-env"}}
+	chain := ChainProvider{Providers: []Provider{keyring, env}}
+
+	got, err := chain.Get("jira", "alice")
+	if err != nil {
+		t.Fatalf("Get error: %v", err)
+	}
+	if got != "from-env" {
+		t.Fatalf("expected chain to fall through a provider error, got %q", got)
+	}
+}
+
+func TestChainProvider_AllMiss(t *testing.T) {
+	chain := ChainProvider{Providers: []Provider{&fakeProvider{}, &fakeProvider{}}}
+	if _, err := chain.Get("jira", "alice"); err == nil {
+		t.Fatal("expected an error when no provider resolves a value")
+	}
+}
+
+func TestChainProvider_SetWritesThroughFirstProvider(t *testing.T) {
+	first := &fakeProvider{}
+	second := &fakeProvider{}
+	chain := ChainProvider{Providers: []Provider{first, second}}
+
+	if err := chain.Set("jira", "alice", "s3cr3t"); err != nil {
+		t.Fatalf("Set error: %v", err)
+	}
+	if first.values["jira/alice"] != "s3cr3t" {
+		t.Fatalf("expected value written to the first provider, got %v", first.values)
+	}
+	if len(second.values) != 0 {
+		t.Fatalf("expected second provider untouched, got %v", second.values)
+	}
+}
+
+func TestEnvProvider_Get(t *testing.T) {
+	t.Setenv("GIT_AUTOMETA_JIRA_TOKEN", "env-token")
+	got, err := (EnvProvider{}).Get("jira", "alice")
+	if err != nil {
+		t.Fatalf("Get error: %v", err)
+	}
+	if got != "env-token" {
+		t.Fatalf("got %q, want %q", got, "env-token")
+	}
+}
+
+func TestEnvProvider_GetUnset(t *testing.T) {
+	got, err := (EnvProvider{}).Get("jira", "alice")
+	if err != nil {
+		t.Fatalf("Get error: %v", err)
+	}
+	if got != "" {
+		t.Fatalf("expected empty value for an unset env var, got %q", got)
+	}
+}
+
+func TestNetrcProvider_NoHost(t *testing.T) {
+	got, err := (NetrcProvider{}).Get("jira", "alice")
+	if err != nil {
+		t.Fatalf("Get error: %v", err)
+	}
+	if got != "" {
+		t.Fatalf("expected no lookup without a host, got %q", got)
+	}
+}
+
+func TestGitCredentialProvider_ParsesPasswordLine(t *testing.T) {
+	p := GitCredentialProvider{
+		Host: "jira.example.com",
+		run: func(action, input string) (string, error) {
+			if action != "fill" {
+				t.Fatalf("unexpected action %q", action)
+			}
+			return "protocol=https\nhost=jira.example.com\nusername=alice\npassword=from-helper\n", nil
+		},
+	}
+	got, err := p.Get("jira", "alice")
+	if err != nil {
+		t.Fatalf("Get error: %v", err)
+	}
+	if got != "from-helper" {
+		t.Fatalf("got %q, want %q", got, "from-helper")
+	}
+}
+
+func TestGitCredentialProvider_NoHelperConfigured(t *testing.T) {
+	p := GitCredentialProvider{
+		Host: "jira.example.com",
+		run: func(action, input string) (string, error) {
+			return "", errors.New("git: 'credential-fill' is not a git command")
+		},
+	}
+	got, err := p.Get("jira", "alice")
+	if err != nil {
+		t.Fatalf("expected a missing helper to be treated as a miss, got error: %v", err)
+	}
+	if got != "" {
+		t.Fatalf("expected empty value, got %q", got)
+	}
+}
+
+func TestGitCredentialProvider_NoHost(t *testing.T) {
+	got, err := (GitCredentialProvider{}).Get("jira", "alice")
+	if err != nil {
+		t.Fatalf("Get error: %v", err)
+	}
+	if got != "" {
+		t.Fatalf("expected no lookup without a host, got %q", got)
+	}
+}
+
+func TestJiraChain_ForcedSource(t *testing.T) {
+	p, err := jiraChain("jira.example.com", SourceEnv)
+	if err != nil {
+		t.Fatalf("jiraChain error: %v", err)
+	}
+	if _, ok := p.(EnvProvider); !ok {
+		t.Fatalf("expected an EnvProvider, got %T", p)
+	}
+}
+
+func TestJiraChain_UnknownSource(t *testing.T) {
+	if _, err := jiraChain("jira.example.com", "carrier-pigeon"); err == nil {
+		t.Fatal("expected an error for an unknown credential source")
+	}
+}
+
+func TestJiraChain_DefaultIsFullChain(t *testing.T) {
+	p, err := jiraChain("jira.example.com", "")
+	if err != nil {
+		t.Fatalf("jiraChain error: %v", err)
+	}
+	chain, ok := p.(ChainProvider)
+	if !ok {
+		t.Fatalf("expected a ChainProvider, got %T", p)
+	}
+	if len(chain.Providers) != 4 {
+		t.Fatalf("expected 4 providers in the standard chain, got %d", len(chain.Providers))
+	}
+}
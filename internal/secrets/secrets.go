@@ -3,26 +3,56 @@ package secrets
 import (
 	"errors"
 	"fmt"
+	"net/url"
+	"strings"
 
 	keyring "github.com/zalando/go-keyring"
+
+	appconfig "git-autometa/internal/config"
 )
 
 // A single keyring service to store all secrets for git-autometa.
-// Keys within the service are namespaced (e.g., "jira:<email>").
+// Keys within the service are namespaced per tracker/auth-mode (e.g.,
+// "jira:<email>", "jira-oauth1:<consumer-key>"), via accountKey.
 const serviceName = "git-autometa"
 
+// accountKey namespaces a secret under the given tracker/auth-mode name,
+// so different backends (and, within JIRA, different auth modes) don't
+// collide within the single git-autometa keyring service.
+func accountKey(tracker, identity string) string {
+	return tracker + ":" + identity
+}
+
 func jiraAccountKey(email string) string {
-	return "jira:" + email
+	return accountKey("jira", email)
+}
+
+func jiraOAuth1AccountKey(consumerKey string) string {
+	return accountKey("jira-oauth1", consumerKey)
 }
 
-// GetJiraToken retrieves the Jira API token for the provided email.
-func GetJiraToken(email string) (string, error) {
-	token, err := keyring.Get(serviceName, jiraAccountKey(email))
+func jiraSessionAccountKey(username string) string {
+	return accountKey("jira-session", username)
+}
+
+func sshKeyAccountKey(keyPath string) string {
+	return accountKey("ssh", keyPath)
+}
+
+// GetJiraToken retrieves the Jira API token for cfg.Jira.Email, trying
+// each provider in the standard chain (keyring, git-credential, netrc,
+// env) in turn, or exactly one if cfg.Auth.CredentialSource forces it.
+func GetJiraToken(cfg appconfig.Config) (string, error) {
+	chain, err := jiraChain(jiraHost(cfg.Jira.ServerURL), cfg.Auth.CredentialSource)
+	if err != nil {
+		return "", err
+	}
+	token, err := chain.Get("jira", cfg.Jira.Email)
 	if err != nil {
 		return "", fmt.Errorf("secrets: unable to get jira token: %w", err)
 	}
 	if token == "" {
-		return "", errors.New("secrets: empty jira token in keyring")
+		return "", errors.New("secrets: empty jira token")
 	}
 	return token, nil
 }
@@ -35,3 +65,82 @@ func SetJiraToken(email, token string) error {
 	}
 	return keyring.Set(serviceName, jiraAccountKey(email), token)
 }
+
+// jiraHost extracts the host from a Jira server URL, for the
+// git-credential/netrc providers' host-based lookups. An unparsable URL
+// yields "", which both providers treat as "skip this source".
+func jiraHost(serverURL string) string {
+	u, err := url.Parse(serverURL)
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
+
+// GetJiraOAuth1Token retrieves the OAuth 1.0a access token and token secret
+// for the given consumer key, stored as "<token>:<secret>" by SetJiraOAuth1Token.
+func GetJiraOAuth1Token(consumerKey string) (token string, secret string, err error) {
+	raw, err := keyring.Get(serviceName, jiraOAuth1AccountKey(consumerKey))
+	if err != nil {
+		return "", "", fmt.Errorf("secrets: unable to get jira oauth1 token: %w", err)
+	}
+	t, s, ok := strings.Cut(raw, ":")
+	if !ok || t == "" || s == "" {
+		return "", "", errors.New("secrets: malformed jira oauth1 token in keyring")
+	}
+	return t, s, nil
+}
+
+// SetJiraOAuth1Token stores the OAuth 1.0a access token and token secret
+// obtained from the three-legged handshake, for the given consumer key.
+func SetJiraOAuth1Token(consumerKey, token, secret string) error {
+	if token == "" || secret == "" {
+		return errors.New("secrets: empty jira oauth1 token or secret provided")
+	}
+	return keyring.Set(serviceName, jiraOAuth1AccountKey(consumerKey), token+":"+secret)
+}
+
+// GetJiraSessionPassword retrieves the JIRA Server password for username,
+// used to establish a /rest/auth/1/session cookie session.
+func GetJiraSessionPassword(username string) (string, error) {
+	password, err := keyring.Get(serviceName, jiraSessionAccountKey(username))
+	if err != nil {
+		return "", fmt.Errorf("secrets: unable to get jira session password: %w", err)
+	}
+	if password == "" {
+		return "", errors.New("secrets: empty jira session password in keyring")
+	}
+	return password, nil
+}
+
+// SetJiraSessionPassword stores the JIRA Server password for username.
+func SetJiraSessionPassword(username, password string) error {
+	if password == "" {
+		return errors.New("secrets: empty jira session password provided")
+	}
+	return keyring.Set(serviceName, jiraSessionAccountKey(username), password)
+}
+
+// GetSSHKeyPassphrase retrieves the passphrase for the private key at
+// keyPath, stored under the "ssh:<path>" keyring account by
+// SetSSHKeyPassphrase. A key with no stored passphrase (unencrypted, or
+// already loaded into an agent) is not an error: it returns "".
+func GetSSHKeyPassphrase(keyPath string) (string, error) {
+	passphrase, err := keyring.Get(serviceName, sshKeyAccountKey(keyPath))
+	if errors.Is(err, keyring.ErrNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("secrets: unable to get ssh key passphrase: %w", err)
+	}
+	return passphrase, nil
+}
+
+// SetSSHKeyPassphrase stores the passphrase that decrypts the private key
+// at keyPath.
+func SetSSHKeyPassphrase(keyPath, passphrase string) error {
+	if passphrase == "" {
+		return errors.New("secrets: empty ssh key passphrase provided")
+	}
+	return keyring.Set(serviceName, sshKeyAccountKey(keyPath), passphrase)
+}
@@ -0,0 +1,119 @@
+// Package slug turns arbitrary titles (often non-English JIRA summaries)
+// into strings safe to use as git ref components.
+package slug
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// CaseLower lowercases the slug; CasePreserve leaves casing as-is after
+// transliteration.
+const (
+	CaseLower    = "lower"
+	CasePreserve = "preserve"
+)
+
+// Options configures how Make builds a slug.
+type Options struct {
+	// Separator joins words; typically "-" or "_". Defaults to "-".
+	Separator string
+	// Case is CaseLower (default) or CasePreserve.
+	Case string
+	// MaxLength truncates the result if > 0.
+	MaxLength int
+}
+
+func (o Options) separator() string {
+	if o.Separator == "" {
+		return "-"
+	}
+	return o.Separator
+}
+
+// Make converts s into a slug: it transliterates common non-Latin
+// scripts to ASCII (a small Cyrillic and CJK table, since a full
+// transliteration table for every script isn't practical here), strips
+// diacritics via Unicode NFKD normalization rather than dropping
+// accented letters outright (so "Café déploiement" becomes
+// "cafe-deploiement", not "caf-d-ploiement"), collapses runs of the
+// separator, and finally enforces git's check-ref-format rules.
+func Make(s string, opts Options) string {
+	s = transliterate(s)
+	s = stripDiacritics(s)
+	if opts.Case != CasePreserve {
+		s = strings.ToLower(s)
+	}
+	s = replaceNonWordRuns(s, opts.separator())
+	s = collapseAndTrim(s, opts.separator())
+	if opts.MaxLength > 0 && len(s) > opts.MaxLength {
+		s = s[:opts.MaxLength]
+		s = strings.Trim(s, opts.separator())
+	}
+	return SanitizeRef(s, opts.separator())
+}
+
+// stripDiacritics decomposes s (NFKD) and drops the resulting combining
+// marks, so accented Latin letters fold to their base letter instead of
+// being treated as non-word runes and discarded.
+func stripDiacritics(s string) string {
+	decomposed := norm.NFKD.String(s)
+	var b strings.Builder
+	b.Grow(len(decomposed))
+	for _, r := range decomposed {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// replaceNonWordRuns replaces every run of runes that aren't ASCII
+// letters/digits with a single separator.
+func replaceNonWordRuns(s, sep string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	inRun := false
+	for _, r := range s {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			inRun = false
+			continue
+		}
+		if !inRun {
+			b.WriteString(sep)
+			inRun = true
+		}
+	}
+	return b.String()
+}
+
+// collapseAndTrim collapses repeated separators down to one and trims
+// them from both ends.
+func collapseAndTrim(s, sep string) string {
+	if sep == "" {
+		return s
+	}
+	for strings.Contains(s, sep+sep) {
+		s = strings.ReplaceAll(s, sep+sep, sep)
+	}
+	return strings.Trim(s, sep)
+}
+
+// SanitizeRef applies git's check-ref-format rules that still matter for
+// a single branch-name component: no leading separator or dot, no ".."
+// anywhere, no "@{", and no trailing ".lock". It's exported separately
+// from Make so callers assembling a full branch name out of several
+// slugged pieces (e.g. cli.sanitizeBranchName) can re-run it on the
+// final joined string.
+func SanitizeRef(s, sep string) string {
+	s = strings.ReplaceAll(s, "..", sep)
+	s = strings.ReplaceAll(s, "@{", sep)
+	s = collapseAndTrim(s, sep)
+	s = strings.TrimPrefix(s, ".")
+	s = strings.TrimSuffix(s, ".lock")
+	return collapseAndTrim(s, sep)
+}
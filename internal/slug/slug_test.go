@@ -0,0 +1,67 @@
+package slug
+
+import "testing"
+
+func TestMake_StripsDiacriticsInsteadOfDropping(t *testing.T) {
+	got := Make("Café déploiement", Options{})
+	want := "cafe-deploiement"
+	if got != want {
+		t.Fatalf("Make() = %q, want %q", got, want)
+	}
+}
+
+func TestMake_TransliteratesCyrillic(t *testing.T) {
+	got := Make("Добавить OAuth2", Options{})
+	want := "dobavit-oauth2"
+	if got != want {
+		t.Fatalf("Make() = %q, want %q", got, want)
+	}
+}
+
+func TestMake_TransliteratesCJKTable(t *testing.T) {
+	got := Make("日本語 support", Options{})
+	want := "ri-ben-yu-support"
+	if got != want {
+		t.Fatalf("Make() = %q, want %q", got, want)
+	}
+}
+
+func TestMake_CollapsesSeparatorRuns(t *testing.T) {
+	got := Make("fix   login---bug!!", Options{})
+	want := "fix-login-bug"
+	if got != want {
+		t.Fatalf("Make() = %q, want %q", got, want)
+	}
+}
+
+func TestMake_ConfigurableSeparatorAndCase(t *testing.T) {
+	got := Make("Add OAuth2 Support", Options{Separator: "_", Case: CasePreserve})
+	want := "Add_OAuth2_Support"
+	if got != want {
+		t.Fatalf("Make() = %q, want %q", got, want)
+	}
+}
+
+func TestMake_MaxLength(t *testing.T) {
+	got := Make("this is a very long title that should be truncated", Options{MaxLength: 20})
+	if len(got) > 20 {
+		t.Fatalf("Make() = %q, len %d > 20", got, len(got))
+	}
+}
+
+func TestSanitizeRef_EnforcesCheckRefFormat(t *testing.T) {
+	cases := []struct {
+		in, want string
+	}{
+		{"-leading-dash", "leading-dash"},
+		{"double..dot", "double-dot"},
+		{"weird@{ref", "weird-ref"},
+		{"branch.lock", "branch"},
+	}
+	for _, tc := range cases {
+		got := SanitizeRef(tc.in, "-")
+		if got != tc.want {
+			t.Errorf("SanitizeRef(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package slug
+
+import "strings"
+
+// transliterate replaces runes from scripts that Unicode normalization
+// alone can't fold to ASCII (Cyrillic, and a handful of common CJK
+// characters) with a Latin approximation, before the rest of Make's
+// pipeline runs. It isn't an exhaustive transliteration table for every
+// script; runes it doesn't recognize pass through untouched and are
+// dropped later as non-word runes.
+func transliterate(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		if repl, ok := cyrillic[r]; ok {
+			b.WriteString(repl)
+			continue
+		}
+		if repl, ok := cjk[r]; ok {
+			// Each CJK character is its own "word"; separate
+			// consecutive replacements so they don't run together
+			// (e.g. "中文" -> "zhong wen", not "zhongwen").
+			b.WriteByte(' ')
+			b.WriteString(repl)
+			b.WriteByte(' ')
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// cyrillic is a standard Latin transliteration of the Russian alphabet.
+var cyrillic = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e",
+	'ж': "zh", 'з': "z", 'и': "i", 'й': "i", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+	'ф': "f", 'х': "h", 'ц': "c", 'ч': "ch", 'ш': "sh", 'щ': "sch", 'ъ': "",
+	'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya",
+	'А': "A", 'Б': "B", 'В': "V", 'Г': "G", 'Д': "D", 'Е': "E", 'Ё': "E",
+	'Ж': "Zh", 'З': "Z", 'И': "I", 'Й': "I", 'К': "K", 'Л': "L", 'М': "M",
+	'Н': "N", 'О': "O", 'П': "P", 'Р': "R", 'С': "S", 'Т': "T", 'У': "U",
+	'Ф': "F", 'Х': "H", 'Ц': "C", 'Ч': "Ch", 'Ш': "Sh", 'Щ': "Sch", 'Ъ': "",
+	'Ы': "Y", 'Ь': "", 'Э': "E", 'Ю': "Yu", 'Я': "Ya",
+}
+
+// cjk is a small, illustrative table covering characters common in
+// example issue titles. Anything outside it is not transliterated.
+var cjk = map[rune]string{
+	'中': "zhong", '文': "wen", '日': "ri", '本': "ben", '語': "yu",
+	'台': "tai", '灣': "wan",
+}
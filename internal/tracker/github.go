@@ -0,0 +1,184 @@
+package tracker
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"git-autometa/internal/auth"
+	appconfig "git-autometa/internal/config"
+)
+
+func init() {
+	Register(appconfig.TrackerProviderGitHub, newGitHubTracker)
+}
+
+// GitHubKeyPattern matches a GitHub issue reference: a bare "#123"
+// (resolved against the configured github.owner/github.repo), or an
+// explicit "owner/repo#123" for an issue in another repository.
+var GitHubKeyPattern = regexp.MustCompile(`(?:[\w.-]+/[\w.-]+)?#\d+`)
+
+var githubKeyParts = regexp.MustCompile(`^(?:([\w.-]+)/([\w.-]+))?#(\d+)$`)
+
+const githubTrackerAPIBase = "https://api.github.com"
+
+// githubTracker talks to GitHub's REST v3 Issues API directly, the same
+// way gitlabTracker talks to GitLab's issues API.
+type githubTracker struct {
+	owner      string
+	repo       string
+	token      string
+	baseURL    string
+	httpClient *http.Client
+}
+
+func newGitHubTracker(cfg appconfig.Config) (Tracker, error) {
+	token := auth.ResolveToken(cfg.GitHub.Token, "GITHUB_TOKEN", "api.github.com")
+	if token == "" {
+		return nil, fmt.Errorf("github: no token configured (set github.token, $GITHUB_TOKEN, or a ~/.netrc entry for api.github.com)")
+	}
+	return &githubTracker{
+		owner:      strings.TrimSpace(cfg.GitHub.Owner),
+		repo:       strings.TrimSpace(cfg.GitHub.Repo),
+		token:      token,
+		baseURL:    githubTrackerAPIBase,
+		httpClient: &http.Client{Timeout: 20 * time.Second},
+	}, nil
+}
+
+func (g *githubTracker) Name() string { return "github" }
+
+func (g *githubTracker) KeyPattern() *regexp.Regexp { return GitHubKeyPattern }
+
+func (g *githubTracker) TestConnection() error {
+	if g.owner == "" || g.repo == "" {
+		return fmt.Errorf("github: no repository configured (set github.owner/github.repo)")
+	}
+	_, err := g.do(http.MethodGet, fmt.Sprintf("/repos/%s/%s", g.owner, g.repo), nil)
+	return err
+}
+
+func (g *githubTracker) GetIssue(key string) (*Issue, error) {
+	owner, repo, number, err := parseGitHubKey(key, g.owner, g.repo)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := g.do(http.MethodGet, fmt.Sprintf("/repos/%s/%s/issues/%d", owner, repo, number), nil)
+	if err != nil {
+		return nil, err
+	}
+	var gi githubIssue
+	if err := json.Unmarshal(resp, &gi); err != nil {
+		return nil, fmt.Errorf("github: unable to parse issue response: %w", err)
+	}
+	return gi.toIssue(), nil
+}
+
+func (g *githubTracker) SearchMyIssues(limit int) ([]Issue, error) {
+	if g.owner == "" || g.repo == "" {
+		return nil, fmt.Errorf("github: no repository configured (set github.owner/github.repo)")
+	}
+	if limit <= 0 {
+		limit = 25
+	}
+	q := fmt.Sprintf("repo:%s/%s is:issue state:open assignee:@me", g.owner, g.repo)
+	resp, err := g.do(http.MethodGet, "/search/issues?q="+url.QueryEscape(q)+"&per_page="+strconv.Itoa(limit), nil)
+	if err != nil {
+		return nil, err
+	}
+	var result struct {
+		Items []githubIssue `json:"items"`
+	}
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("github: unable to parse issue search results: %w", err)
+	}
+	out := make([]Issue, len(result.Items))
+	for i, gi := range result.Items {
+		out[i] = *gi.toIssue()
+	}
+	return out, nil
+}
+
+// githubIssue mirrors the fields we care about from GitHub's issue JSON.
+type githubIssue struct {
+	Number   int    `json:"number"`
+	Title    string `json:"title"`
+	Body     string `json:"body"`
+	State    string `json:"state"`
+	HTMLURL  string `json:"html_url"`
+	Assignee *struct {
+		Login string `json:"login"`
+	} `json:"assignee"`
+}
+
+func (gi githubIssue) toIssue() *Issue {
+	issue := &Issue{
+		Key:         fmt.Sprintf("#%d", gi.Number),
+		Summary:     gi.Title,
+		Description: gi.Body,
+		IssueType:   "issue",
+		Status:      gi.State,
+		URL:         gi.HTMLURL,
+	}
+	if gi.Assignee != nil {
+		issue.Assignee = gi.Assignee.Login
+	}
+	return issue
+}
+
+// parseGitHubKey parses a key like "#42" or "owner/repo#42" into its
+// owner, repo, and issue number, falling back to defaultOwner/defaultRepo
+// when the key doesn't name a repository explicitly.
+func parseGitHubKey(key, defaultOwner, defaultRepo string) (owner, repo string, number int, err error) {
+	m := githubKeyParts.FindStringSubmatch(strings.TrimSpace(key))
+	if m == nil {
+		return "", "", 0, fmt.Errorf("github: invalid issue key %q, expected '#123' or 'owner/repo#123'", key)
+	}
+	owner, repo = m[1], m[2]
+	if owner == "" {
+		owner = defaultOwner
+	}
+	if repo == "" {
+		repo = defaultRepo
+	}
+	if owner == "" || repo == "" {
+		return "", "", 0, fmt.Errorf("github: no repository configured for bare issue key %q (set github.owner/github.repo)", key)
+	}
+	number, err = strconv.Atoi(m[3])
+	if err != nil {
+		return "", "", 0, fmt.Errorf("github: invalid issue number in %q: %w", key, err)
+	}
+	return owner, repo, number, nil
+}
+
+func (g *githubTracker) do(method, path string, body []byte) ([]byte, error) {
+	req, err := http.NewRequest(method, g.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+g.token)
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := g.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("github: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("github: reading response body: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("github: %s %s returned %d: %s", method, path, resp.StatusCode, string(data))
+	}
+	return data, nil
+}
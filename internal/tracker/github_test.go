@@ -0,0 +1,104 @@
+package tracker
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestGitHubTracker(t *testing.T, owner, repo string, handler http.HandlerFunc) (*githubTracker, *httptest.Server) {
+	t.Helper()
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+	return &githubTracker{
+		owner:      owner,
+		repo:       repo,
+		token:      "tok",
+		baseURL:    ts.URL,
+		httpClient: ts.Client(),
+	}, ts
+}
+
+func TestGitHubKeyPattern(t *testing.T) {
+	assert.True(t, GitHubKeyPattern.MatchString("#42"))
+	assert.True(t, GitHubKeyPattern.MatchString("acme/widgets#42"))
+	assert.False(t, GitHubKeyPattern.MatchString("ABC-123"))
+}
+
+func TestParseGitHubKey_Bare(t *testing.T) {
+	owner, repo, number, err := parseGitHubKey("#42", "acme", "widgets")
+	require.NoError(t, err)
+	assert.Equal(t, "acme", owner)
+	assert.Equal(t, "widgets", repo)
+	assert.Equal(t, 42, number)
+}
+
+func TestParseGitHubKey_Qualified(t *testing.T) {
+	owner, repo, number, err := parseGitHubKey("other/project#7", "acme", "widgets")
+	require.NoError(t, err)
+	assert.Equal(t, "other", owner)
+	assert.Equal(t, "project", repo)
+	assert.Equal(t, 7, number)
+}
+
+func TestParseGitHubKey_BareWithoutDefaultRepo(t *testing.T) {
+	_, _, _, err := parseGitHubKey("#42", "", "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no repository configured")
+}
+
+func TestParseGitHubKey_Invalid(t *testing.T) {
+	_, _, _, err := parseGitHubKey("not-a-key", "acme", "widgets")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid issue key")
+}
+
+func TestGitHubTracker_GetIssue(t *testing.T) {
+	g, _ := newTestGitHubTracker(t, "acme", "widgets", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/repos/acme/widgets/issues/42", r.URL.Path)
+		require.Equal(t, "Bearer tok", r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"number":42,"title":"Fix bug","body":"details","state":"open","html_url":"u","assignee":{"login":"octocat"}}`))
+	})
+
+	issue, err := g.GetIssue("#42")
+	require.NoError(t, err)
+	assert.Equal(t, &Issue{Key: "#42", Summary: "Fix bug", Description: "details", IssueType: "issue", Status: "open", Assignee: "octocat", URL: "u"}, issue)
+}
+
+func TestGitHubTracker_SearchMyIssues(t *testing.T) {
+	g, _ := newTestGitHubTracker(t, "acme", "widgets", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/search/issues", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"items":[{"number":1,"title":"A","state":"open"}]}`))
+	})
+
+	issues, err := g.SearchMyIssues(10)
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "#1", issues[0].Key)
+}
+
+func TestGitHubTracker_SearchMyIssues_RequiresRepo(t *testing.T) {
+	g, _ := newTestGitHubTracker(t, "", "", func(w http.ResponseWriter, r *http.Request) {
+		require.Fail(t, "server should not be called without a configured repo")
+	})
+
+	_, err := g.SearchMyIssues(10)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no repository configured")
+}
+
+func TestGitHubTracker_ErrorStatus(t *testing.T) {
+	g, _ := newTestGitHubTracker(t, "acme", "widgets", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message":"not found"}`))
+	})
+
+	_, err := g.GetIssue("#42")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "404")
+}
@@ -0,0 +1,166 @@
+package tracker
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	appconfig "git-autometa/internal/config"
+)
+
+func init() {
+	Register(appconfig.TrackerProviderGitLab, newGitLabTracker)
+}
+
+// GitLabKeyPattern matches a GitLab issue reference: a bare "!42" or one
+// qualified with its project path, "owner/repo!42".
+var GitLabKeyPattern = regexp.MustCompile(`(?:[\w.-]+/[\w.-]+)?!\d+`)
+
+// gitlabTracker talks to the GitLab REST v4 issues API directly, the same
+// way forge.gitlabForge talks to its merge-requests API.
+type gitlabTracker struct {
+	baseURL     string // e.g. https://gitlab.com
+	token       string
+	projectPath string // URL-encoded "owner/repo"
+	httpClient  *http.Client
+}
+
+func newGitLabTracker(cfg appconfig.Config) (Tracker, error) {
+	host := strings.TrimSpace(cfg.Forges.GitLab.Host)
+	if host == "" {
+		host = "gitlab.com"
+	}
+	token := strings.TrimSpace(cfg.Forges.GitLab.Token)
+	if token == "" {
+		return nil, fmt.Errorf("gitlab: no token configured (set forges.gitlab.token)")
+	}
+	projectPath := strings.TrimSpace(cfg.Tracker.GitLab.ProjectPath)
+	if projectPath == "" {
+		return nil, fmt.Errorf("gitlab: no project configured (set tracker.gitlab.project_path)")
+	}
+	return gitlabTracker{
+		baseURL:     "https://" + host,
+		token:       token,
+		projectPath: url.PathEscape(projectPath),
+		httpClient:  &http.Client{Timeout: 20 * time.Second},
+	}, nil
+}
+
+func (g gitlabTracker) TestConnection() error {
+	_, err := g.do(http.MethodGet, "/projects/"+g.projectPath, nil)
+	return err
+}
+
+func (g gitlabTracker) Name() string { return "gitlab" }
+
+func (g gitlabTracker) KeyPattern() *regexp.Regexp { return GitLabKeyPattern }
+
+func (g gitlabTracker) GetIssue(key string) (*Issue, error) {
+	iid, err := issueIID(key)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := g.do(http.MethodGet, fmt.Sprintf("/projects/%s/issues/%d", g.projectPath, iid), nil)
+	if err != nil {
+		return nil, err
+	}
+	var gi gitlabIssue
+	if err := json.Unmarshal(resp, &gi); err != nil {
+		return nil, fmt.Errorf("gitlab: unable to parse issue response: %w", err)
+	}
+	return gi.toIssue(), nil
+}
+
+func (g gitlabTracker) SearchMyIssues(limit int) ([]Issue, error) {
+	q := url.Values{"scope": {"assigned_to_me"}, "state": {"opened"}}
+	if limit > 0 {
+		q.Set("per_page", strconv.Itoa(limit))
+	}
+	resp, err := g.do(http.MethodGet, "/projects/"+g.projectPath+"/issues?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	var gis []gitlabIssue
+	if err := json.Unmarshal(resp, &gis); err != nil {
+		return nil, fmt.Errorf("gitlab: unable to parse issue list: %w", err)
+	}
+	out := make([]Issue, len(gis))
+	for i, gi := range gis {
+		out[i] = *gi.toIssue()
+	}
+	return out, nil
+}
+
+// gitlabIssue mirrors the fields we care about from GitLab's issue JSON.
+type gitlabIssue struct {
+	IID         int    `json:"iid"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	State       string `json:"state"`
+	WebURL      string `json:"web_url"`
+	Assignee    *struct {
+		Name string `json:"name"`
+	} `json:"assignee"`
+}
+
+func (gi gitlabIssue) toIssue() *Issue {
+	issue := &Issue{
+		Key:         fmt.Sprintf("!%d", gi.IID),
+		Summary:     gi.Title,
+		Description: gi.Description,
+		IssueType:   "issue",
+		Status:      gi.State,
+		URL:         gi.WebURL,
+	}
+	if gi.Assignee != nil {
+		issue.Assignee = gi.Assignee.Name
+	}
+	return issue
+}
+
+// gitlabKeyParts splits a key like "!42" or "owner/repo!42" into its
+// optional project-path and numeric IID, mirroring githubKeyParts.
+var gitlabKeyParts = regexp.MustCompile(`^(?:([\w.-]+/[\w.-]+))?!(\d+)$`)
+
+// issueIID parses a key like "!42" or "owner/repo!42" into GitLab's numeric
+// IID. The project-path part, if present, is accepted for parity with
+// GitHubKeyPattern's "owner/repo#123" form but otherwise ignored, since
+// gitlabTracker is already scoped to a single project via projectPath.
+func issueIID(key string) (int, error) {
+	m := gitlabKeyParts.FindStringSubmatch(key)
+	if m == nil {
+		return 0, fmt.Errorf("gitlab: invalid issue key %q, expected '!123' or 'owner/repo!123'", key)
+	}
+	return strconv.Atoi(m[2])
+}
+
+func (g gitlabTracker) do(method, path string, body []byte) ([]byte, error) {
+	req, err := http.NewRequest(method, g.baseURL+"/api/v4"+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("PRIVATE-TOKEN", g.token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := g.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("gitlab: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("gitlab: reading response body: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("gitlab: %s %s returned %d: %s", method, path, resp.StatusCode, string(data))
+	}
+	return data, nil
+}
@@ -0,0 +1,100 @@
+package tracker
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appconfig "git-autometa/internal/config"
+)
+
+func newTestGitLabTracker(t *testing.T, handler http.HandlerFunc) (gitlabTracker, *httptest.Server) {
+	t.Helper()
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+	return gitlabTracker{
+		baseURL:     ts.URL,
+		token:       "tok",
+		projectPath: "acme%2Fwidgets",
+		httpClient:  ts.Client(),
+	}, ts
+}
+
+func TestGitLabKeyPattern(t *testing.T) {
+	assert.True(t, GitLabKeyPattern.MatchString("!42"))
+	assert.True(t, GitLabKeyPattern.MatchString("acme/widgets!42"))
+	assert.False(t, GitLabKeyPattern.MatchString("#42"))
+}
+
+func TestIssueIID_Bare(t *testing.T) {
+	iid, err := issueIID("!42")
+	require.NoError(t, err)
+	assert.Equal(t, 42, iid)
+}
+
+func TestIssueIID_Qualified(t *testing.T) {
+	iid, err := issueIID("acme/widgets!7")
+	require.NoError(t, err)
+	assert.Equal(t, 7, iid)
+}
+
+func TestIssueIID_Invalid(t *testing.T) {
+	_, err := issueIID("#42")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid issue key")
+}
+
+func TestGitLabTracker_GetIssue(t *testing.T) {
+	g, _ := newTestGitLabTracker(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v4/projects/acme%2Fwidgets/issues/42", r.URL.EscapedPath())
+		require.Equal(t, "tok", r.Header.Get("PRIVATE-TOKEN"))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"iid":42,"title":"Fix bug","description":"details","state":"opened","web_url":"u","assignee":{"name":"Ada"}}`))
+	})
+
+	issue, err := g.GetIssue("!42")
+	require.NoError(t, err)
+	assert.Equal(t, &Issue{Key: "!42", Summary: "Fix bug", Description: "details", IssueType: "issue", Status: "opened", Assignee: "Ada", URL: "u"}, issue)
+}
+
+func TestGitLabTracker_SearchMyIssues(t *testing.T) {
+	g, _ := newTestGitLabTracker(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "scope=assigned_to_me&state=opened", r.URL.RawQuery)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[{"iid":1,"title":"A","state":"opened"}]`))
+	})
+
+	issues, err := g.SearchMyIssues(0)
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "!1", issues[0].Key)
+}
+
+func TestGitLabTracker_ErrorStatus(t *testing.T) {
+	g, _ := newTestGitLabTracker(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"message":"forbidden"}`))
+	})
+
+	_, err := g.GetIssue("!42")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "403")
+}
+
+func TestNewGitLabTracker_RequiresToken(t *testing.T) {
+	cfg := appconfig.Config{Tracker: appconfig.TrackerConfig{GitLab: appconfig.GitLabIssuesConfig{ProjectPath: "acme/widgets"}}}
+	_, err := newGitLabTracker(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "token")
+}
+
+func TestNewGitLabTracker_RequiresProjectPath(t *testing.T) {
+	cfg := appconfig.Config{}
+	cfg.Forges.GitLab.Token = "tok"
+	_, err := newGitLabTracker(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "project")
+}
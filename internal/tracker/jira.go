@@ -0,0 +1,84 @@
+package tracker
+
+import (
+	"regexp"
+
+	appconfig "git-autometa/internal/config"
+	"git-autometa/internal/jira"
+)
+
+func init() {
+	Register(appconfig.TrackerProviderJira, newJiraTracker)
+}
+
+// JiraKeyPattern matches a JIRA issue key like "ABC-123": an upper-case
+// project key followed by a dash and a numeric id.
+var JiraKeyPattern = regexp.MustCompile(`[A-Z][A-Z0-9]+-\d+`)
+
+// jiraTracker adapts jira.Client to the Tracker interface.
+type jiraTracker struct {
+	client jira.Client
+}
+
+func newJiraTracker(cfg appconfig.Config) (Tracker, error) {
+	client, err := jira.NewClientWithKeyring(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return jiraTracker{client: client}, nil
+}
+
+func (t jiraTracker) GetIssue(key string) (*Issue, error) {
+	iss, err := t.client.GetIssue(key)
+	if err != nil {
+		return nil, err
+	}
+	return fromJiraIssue(iss), nil
+}
+
+func (t jiraTracker) SearchMyIssues(limit int) ([]Issue, error) {
+	issues, err := t.client.SearchMyIssues(limit)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Issue, len(issues))
+	for i := range issues {
+		out[i] = *fromJiraIssue(&issues[i])
+	}
+	return out, nil
+}
+
+func (t jiraTracker) TestConnection() error {
+	return t.client.TestConnection()
+}
+
+func (t jiraTracker) Name() string { return "jira" }
+
+func (t jiraTracker) KeyPattern() *regexp.Regexp { return JiraKeyPattern }
+
+// SearchIssues implements JQLSearcher, giving callers (the start-work
+// issue picker) an escape hatch to JIRA's native query language beyond
+// the fixed "my issues" search.
+func (t jiraTracker) SearchIssues(query string, limit int) ([]Issue, error) {
+	issues, err := t.client.SearchIssues(query, limit)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Issue, len(issues))
+	for i := range issues {
+		out[i] = *fromJiraIssue(&issues[i])
+	}
+	return out, nil
+}
+
+func fromJiraIssue(iss *jira.Issue) *Issue {
+	return &Issue{
+		Key:         iss.Key,
+		Summary:     iss.Summary,
+		Description: iss.DescriptionMarkdown(),
+		IssueType:   iss.IssueType,
+		Status:      iss.Status,
+		Assignee:    iss.Assignee,
+		URL:         iss.URL,
+	}
+}
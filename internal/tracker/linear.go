@@ -0,0 +1,169 @@
+package tracker
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	appconfig "git-autometa/internal/config"
+)
+
+func init() {
+	Register(appconfig.TrackerProviderLinear, newLinearTracker)
+}
+
+const linearAPIURL = "https://api.linear.app/graphql"
+
+// LinearKeyPattern matches a Linear issue identifier like "ENG-123": a
+// team key followed by a dash and a numeric id.
+var LinearKeyPattern = regexp.MustCompile(`[A-Z][A-Z0-9]*-\d+`)
+
+// linearTracker talks to Linear's GraphQL API, authenticating with a
+// personal API key sent verbatim in the Authorization header (Linear
+// does not use the "Bearer" scheme for personal keys).
+type linearTracker struct {
+	apiKey     string
+	apiURL     string
+	httpClient *http.Client
+}
+
+func newLinearTracker(cfg appconfig.Config) (Tracker, error) {
+	apiKey := strings.TrimSpace(cfg.Tracker.Linear.APIKey)
+	if apiKey == "" {
+		return nil, fmt.Errorf("linear: no API key configured (set tracker.linear.api_key)")
+	}
+	return linearTracker{
+		apiKey:     apiKey,
+		apiURL:     linearAPIURL,
+		httpClient: &http.Client{Timeout: 20 * time.Second},
+	}, nil
+}
+
+func (l linearTracker) TestConnection() error {
+	var resp struct {
+		Viewer struct {
+			ID string `json:"id"`
+		} `json:"viewer"`
+	}
+	return l.query(`query { viewer { id } }`, nil, &resp)
+}
+
+func (l linearTracker) Name() string { return "linear" }
+
+func (l linearTracker) KeyPattern() *regexp.Regexp { return LinearKeyPattern }
+
+func (l linearTracker) GetIssue(key string) (*Issue, error) {
+	var resp struct {
+		Issue *linearIssue `json:"issue"`
+	}
+	if err := l.query(
+		`query($id: String!) { issue(id: $id) { identifier title description url state { name } assignee { name } } }`,
+		map[string]any{"id": key},
+		&resp,
+	); err != nil {
+		return nil, err
+	}
+	if resp.Issue == nil {
+		return nil, fmt.Errorf("linear: issue %q not found", key)
+	}
+	return resp.Issue.toIssue(), nil
+}
+
+func (l linearTracker) SearchMyIssues(limit int) ([]Issue, error) {
+	if limit <= 0 {
+		limit = 25
+	}
+	var resp struct {
+		Viewer struct {
+			AssignedIssues struct {
+				Nodes []linearIssue `json:"nodes"`
+			} `json:"assignedIssues"`
+		} `json:"viewer"`
+	}
+	if err := l.query(
+		`query($first: Int!) { viewer { assignedIssues(first: $first) { nodes { identifier title description url state { name } assignee { name } } } } }`,
+		map[string]any{"first": limit},
+		&resp,
+	); err != nil {
+		return nil, err
+	}
+	nodes := resp.Viewer.AssignedIssues.Nodes
+	out := make([]Issue, len(nodes))
+	for i, n := range nodes {
+		out[i] = *n.toIssue()
+	}
+	return out, nil
+}
+
+// linearIssue mirrors the fields we request from Linear's GraphQL schema.
+type linearIssue struct {
+	Identifier  string `json:"identifier"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	URL         string `json:"url"`
+	State       struct {
+		Name string `json:"name"`
+	} `json:"state"`
+	Assignee *struct {
+		Name string `json:"name"`
+	} `json:"assignee"`
+}
+
+func (li linearIssue) toIssue() *Issue {
+	issue := &Issue{
+		Key:         li.Identifier,
+		Summary:     li.Title,
+		Description: li.Description,
+		IssueType:   "issue",
+		Status:      li.State.Name,
+		URL:         li.URL,
+	}
+	if li.Assignee != nil {
+		issue.Assignee = li.Assignee.Name
+	}
+	return issue
+}
+
+func (l linearTracker) query(query string, variables map[string]any, out any) error {
+	payload, err := json.Marshal(map[string]any{"query": query, "variables": variables})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, l.apiURL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", l.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := l.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("linear: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("linear: reading response body: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("linear: request returned %d: %s", resp.StatusCode, string(data))
+	}
+	var envelope struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return fmt.Errorf("linear: unable to parse response: %w", err)
+	}
+	if len(envelope.Errors) > 0 {
+		return fmt.Errorf("linear: %s", envelope.Errors[0].Message)
+	}
+	return json.Unmarshal(envelope.Data, out)
+}
@@ -0,0 +1,86 @@
+package tracker
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appconfig "git-autometa/internal/config"
+)
+
+func newTestLinearTracker(t *testing.T, handler http.HandlerFunc) (linearTracker, *httptest.Server) {
+	t.Helper()
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+	return linearTracker{
+		apiKey:     "key",
+		apiURL:     ts.URL,
+		httpClient: ts.Client(),
+	}, ts
+}
+
+func TestLinearKeyPattern(t *testing.T) {
+	assert.True(t, LinearKeyPattern.MatchString("ENG-123"))
+	assert.False(t, LinearKeyPattern.MatchString("#123"))
+}
+
+func TestLinearTracker_GetIssue(t *testing.T) {
+	l, _ := newTestLinearTracker(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "key", r.Header.Get("Authorization"))
+		var payload struct {
+			Variables map[string]any `json:"variables"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		require.Equal(t, "ENG-123", payload.Variables["id"])
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data":{"issue":{"identifier":"ENG-123","title":"Fix bug","description":"details","url":"u","state":{"name":"In Progress"},"assignee":{"name":"Ada"}}}}`))
+	})
+
+	issue, err := l.GetIssue("ENG-123")
+	require.NoError(t, err)
+	assert.Equal(t, &Issue{Key: "ENG-123", Summary: "Fix bug", Description: "details", IssueType: "issue", Status: "In Progress", Assignee: "Ada", URL: "u"}, issue)
+}
+
+func TestLinearTracker_GetIssue_NotFound(t *testing.T) {
+	l, _ := newTestLinearTracker(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data":{"issue":null}}`))
+	})
+
+	_, err := l.GetIssue("ENG-123")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
+
+func TestLinearTracker_SearchMyIssues(t *testing.T) {
+	l, _ := newTestLinearTracker(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data":{"viewer":{"assignedIssues":{"nodes":[{"identifier":"ENG-1","title":"A","state":{"name":"Todo"}}]}}}}`))
+	})
+
+	issues, err := l.SearchMyIssues(0)
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "ENG-1", issues[0].Key)
+}
+
+func TestLinearTracker_GraphQLError(t *testing.T) {
+	l, _ := newTestLinearTracker(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"errors":[{"message":"not authorized"}]}`))
+	})
+
+	_, err := l.GetIssue("ENG-123")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not authorized")
+}
+
+func TestNewLinearTracker_RequiresAPIKey(t *testing.T) {
+	_, err := newLinearTracker(appconfig.Config{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "API key")
+}
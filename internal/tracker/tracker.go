@@ -0,0 +1,97 @@
+// Package tracker abstracts fetching and searching issues across
+// issue-tracking backends (JIRA, GitLab Issues, Linear, ...), mirroring
+// the forge package's abstraction over code-hosting pull/merge requests.
+package tracker
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	appconfig "git-autometa/internal/config"
+	"git-autometa/internal/slug"
+)
+
+// Issue is the backend-agnostic view of a tracked issue. Concrete
+// trackers populate it from whatever shape their API returns.
+type Issue struct {
+	Key         string
+	Summary     string
+	Description string
+	IssueType   string
+	Status      string
+	Assignee    string
+	URL         string
+}
+
+// DescriptionMarkdown returns the issue description as Markdown.
+// Trackers whose native format isn't Markdown (e.g. JIRA's wiki markup)
+// convert it before populating Description, so this is a plain accessor.
+func (i *Issue) DescriptionMarkdown() string {
+	return i.Description
+}
+
+// SlugifyTitle slugs the issue's summary per opts. See internal/slug for
+// how non-ASCII titles are transliterated rather than gutted.
+func (i *Issue) SlugifyTitle(opts slug.Options) string {
+	if i.Summary == "" {
+		return ""
+	}
+	return slug.Make(i.Summary, opts)
+}
+
+// Tracker fetches and searches issues from a single configured backend.
+type Tracker interface {
+	// GetIssue fetches a single issue by its key/ID.
+	GetIssue(key string) (*Issue, error)
+	// SearchMyIssues lists issues assigned to the authenticated user,
+	// most relevant first, capped at limit.
+	SearchMyIssues(limit int) ([]Issue, error)
+	// TestConnection verifies the tracker is reachable with the
+	// configured credentials.
+	TestConnection() error
+	// Name identifies the backend ("jira", "github", "gitlab", "linear"),
+	// for diagnostics and per-tracker credential lookups.
+	Name() string
+	// KeyPattern matches this tracker's issue-key syntax within free text
+	// like a branch name, e.g. JIRA's "ABC-123" or GitHub's "#123".
+	KeyPattern() *regexp.Regexp
+}
+
+// JQLSearcher is an optional Tracker capability for running an ad-hoc
+// query beyond the fixed "my issues" search (JIRA's JQL, or an
+// equivalent free-text query in another backend). Callers that want it
+// check for it with a type assertion, since only JIRA implements it
+// today.
+type JQLSearcher interface {
+	SearchIssues(query string, limit int) ([]Issue, error)
+}
+
+// factory builds a Tracker from config. Implementations register
+// themselves in init() rather than being listed here, so adding a new
+// backend doesn't require editing this file.
+type factory func(cfg appconfig.Config) (Tracker, error)
+
+var providers = map[string]factory{}
+
+// Register adds a tracker backend under name, so New(cfg) can build it
+// when cfg.Tracker.Provider == name. Intended to be called from each
+// backend's init().
+func Register(name string, f factory) {
+	providers[name] = f
+}
+
+// New builds the Tracker for cfg.Tracker.Provider, defaulting to "jira"
+// so configs written before tracker.provider existed keep working
+// unchanged.
+func New(cfg appconfig.Config) (Tracker, error) {
+	name := strings.TrimSpace(cfg.Tracker.Provider)
+	if name == "" {
+		name = appconfig.TrackerProviderJira
+	}
+	f, ok := providers[name]
+	if !ok {
+		return nil, fmt.Errorf("tracker: unsupported provider %q", name)
+	}
+	return f(cfg)
+}
@@ -0,0 +1,34 @@
+package tracker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appconfig "git-autometa/internal/config"
+)
+
+func TestNew_UnsupportedProvider(t *testing.T) {
+	cfg := appconfig.Config{Tracker: appconfig.TrackerConfig{Provider: "bitbucket"}}
+	_, err := New(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "bitbucket")
+}
+
+func TestNew_DefaultsToJira(t *testing.T) {
+	cfg := appconfig.Config{}
+	_, err := New(cfg)
+	// jiraTracker's own constructor fails without credentials configured;
+	// what matters here is that New() dispatched to it rather than
+	// erroring out with "unsupported provider".
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "unsupported provider")
+}
+
+func TestNew_DispatchesToRegisteredProvider(t *testing.T) {
+	cfg := appconfig.Config{Tracker: appconfig.TrackerConfig{Provider: appconfig.TrackerProviderGitLab}}
+	_, err := New(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "gitlab")
+}